@@ -0,0 +1,21 @@
+package xsens
+
+// AlignmentFrame identifies the frame an alignment rotation applies to.
+type AlignmentFrame uint8
+
+//go:generate stringer -type AlignmentFrame -trimprefix AlignmentFrame
+
+const (
+	// AlignmentFrameSensor is the sensor alignment frame (RotSensor), rotating the sensor frame S to the output
+	// object frame O.
+	AlignmentFrameSensor AlignmentFrame = 0
+
+	// AlignmentFrameLocal is the local alignment frame (RotLocal), rotating the local tangent plane L to the
+	// output local frame.
+	AlignmentFrameLocal AlignmentFrame = 1
+)
+
+// MarshalText implements encoding.TextMarshaler.
+func (f AlignmentFrame) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
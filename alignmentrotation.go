@@ -0,0 +1,41 @@
+package xsens
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// AlignmentRotation is the alignment rotation of an Xsens device in the sensor or local frame.
+type AlignmentRotation struct {
+	// Frame is the frame the alignment rotation applies to.
+	Frame AlignmentFrame
+	// Quaternion is the alignment rotation, with Q0 the real component.
+	Quaternion Quaternion
+}
+
+const alignmentRotationLength = 17
+
+// MarshalBinary returns the wire representation of the alignment rotation.
+func (a *AlignmentRotation) MarshalBinary() ([]byte, error) {
+	result := make([]byte, alignmentRotationLength)
+	result[0] = uint8(a.Frame)
+	binary.BigEndian.PutUint32(result[1:], math.Float32bits(float32(a.Quaternion.Q0)))
+	binary.BigEndian.PutUint32(result[5:], math.Float32bits(float32(a.Quaternion.Q1)))
+	binary.BigEndian.PutUint32(result[9:], math.Float32bits(float32(a.Quaternion.Q2)))
+	binary.BigEndian.PutUint32(result[13:], math.Float32bits(float32(a.Quaternion.Q3)))
+	return result, nil
+}
+
+// UnmarshalBinary sets *a from a wire representation of the alignment rotation.
+func (a *AlignmentRotation) UnmarshalBinary(data []byte) error {
+	if l := len(data); l != alignmentRotationLength {
+		return fmt.Errorf("unexpected AlignmentRotation length: want: %d, got: %d", alignmentRotationLength, l)
+	}
+	a.Frame = AlignmentFrame(data[0])
+	a.Quaternion.Q0 = float64(math.Float32frombits(binary.BigEndian.Uint32(data[1:])))
+	a.Quaternion.Q1 = float64(math.Float32frombits(binary.BigEndian.Uint32(data[5:])))
+	a.Quaternion.Q2 = float64(math.Float32frombits(binary.BigEndian.Uint32(data[9:])))
+	a.Quaternion.Q3 = float64(math.Float32frombits(binary.BigEndian.Uint32(data[13:])))
+	return nil
+}
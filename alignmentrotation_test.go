@@ -0,0 +1,34 @@
+package xsens
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestAlignmentRotation_MarshalBinary(t *testing.T) {
+	for _, tt := range []AlignmentRotation{
+		{Frame: AlignmentFrameSensor, Quaternion: Quaternion{Q0: 1}},
+		{Frame: AlignmentFrameSensor, Quaternion: Quaternion{Q0: 0.70710677, Q3: 0.70710677}},
+		{Frame: AlignmentFrameLocal, Quaternion: Quaternion{Q0: 0.5, Q1: -0.5, Q2: 0.5, Q3: -0.5}},
+	} {
+		tt := tt
+		t.Run(tt.Frame.String(), func(t *testing.T) {
+			data, err := tt.MarshalBinary()
+			assert.NilError(t, err)
+			assert.Equal(t, alignmentRotationLength, len(data))
+			var actual AlignmentRotation
+			assert.NilError(t, actual.UnmarshalBinary(data))
+			assert.Equal(t, tt.Frame, actual.Frame)
+			assert.Equal(t, float32(tt.Quaternion.Q0), float32(actual.Quaternion.Q0))
+			assert.Equal(t, float32(tt.Quaternion.Q1), float32(actual.Quaternion.Q1))
+			assert.Equal(t, float32(tt.Quaternion.Q2), float32(actual.Quaternion.Q2))
+			assert.Equal(t, float32(tt.Quaternion.Q3), float32(actual.Quaternion.Q3))
+		})
+	}
+}
+
+func TestAlignmentRotation_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var actual AlignmentRotation
+	assert.ErrorContains(t, actual.UnmarshalBinary(make([]byte, 16)), "unexpected AlignmentRotation length")
+}
@@ -0,0 +1,69 @@
+package xsens
+
+import "fmt"
+
+//go:generate stringer -type BaudRateID -trimprefix BaudRateID
+
+type (
+	// BaudRateID identifies a serial baud rate of an Xsens device.
+	BaudRateID uint8
+	// BaudRate is a serial baud rate in bits per second.
+	BaudRate int
+)
+
+const (
+	BaudRateID4M     BaudRateID = 0x0D
+	BaudRateID3686k4 BaudRateID = 0x0E
+	BaudRateID2M     BaudRateID = 0x0C
+	BaudRateID1M     BaudRateID = 0x0A
+	BaudRateID921k6  BaudRateID = 0x80
+	BaudRateID460k8  BaudRateID = 0x00
+	BaudRateID230k4  BaudRateID = 0x01
+	BaudRateID115k2  BaudRateID = 0x02
+	BaudRateID76k8   BaudRateID = 0x03
+	BaudRateID57k6   BaudRateID = 0x04
+	BaudRateID38k4   BaudRateID = 0x05
+	BaudRateID28k8   BaudRateID = 0x06
+	BaudRateID19k2   BaudRateID = 0x07
+	BaudRateID14k4   BaudRateID = 0x08
+	BaudRateID9k6    BaudRateID = 0x09
+	BaudRateID4k8    BaudRateID = 0x0B
+)
+
+// baudRates maps each baud rate ID to its baud rate.
+var baudRates = map[BaudRateID]BaudRate{
+	BaudRateID4M:     4000000,
+	BaudRateID3686k4: 3686400,
+	BaudRateID2M:     2000000,
+	BaudRateID1M:     1000000,
+	BaudRateID921k6:  921600,
+	BaudRateID460k8:  460800,
+	BaudRateID230k4:  230400,
+	BaudRateID115k2:  115200,
+	BaudRateID76k8:   76800,
+	BaudRateID57k6:   57600,
+	BaudRateID38k4:   38400,
+	BaudRateID28k8:   28800,
+	BaudRateID19k2:   19200,
+	BaudRateID14k4:   14400,
+	BaudRateID9k6:    9600,
+	BaudRateID4k8:    4800,
+}
+
+// BaudRate returns the baud rate identified by the ID.
+func (b BaudRateID) BaudRate() (BaudRate, error) {
+	if baudRate, ok := baudRates[b]; ok {
+		return baudRate, nil
+	}
+	return 0, fmt.Errorf("unknown baud rate ID: %#x", uint8(b))
+}
+
+// ID returns the ID of the baud rate.
+func (b BaudRate) ID() (BaudRateID, error) {
+	for id, baudRate := range baudRates {
+		if baudRate == b {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported baud rate: %d", int(b))
+}
@@ -0,0 +1,25 @@
+package xsens
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestBaudRateID_BaudRate(t *testing.T) {
+	for id, baudRate := range baudRates {
+		id, baudRate := id, baudRate
+		t.Run(id.String(), func(t *testing.T) {
+			actualBaudRate, err := id.BaudRate()
+			assert.NilError(t, err)
+			assert.Equal(t, baudRate, actualBaudRate)
+			actualID, err := baudRate.ID()
+			assert.NilError(t, err)
+			assert.Equal(t, id, actualID)
+		})
+	}
+	_, err := BaudRateID(0x7f).BaudRate()
+	assert.ErrorContains(t, err, "unknown baud rate ID")
+	_, err = BaudRate(12345).ID()
+	assert.ErrorContains(t, err, "unsupported baud rate")
+}
@@ -1,10 +1,18 @@
 package xsens
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 //go:generate stringer -type CANBaudRateID -trimprefix CANBaudRateID
 
 type (
+	// CANBaudRateID identifies a CAN baud rate of an Xsens device.
 	CANBaudRateID int8
-	CANBaudRate   int
+	// CANBaudRate is a CAN baud rate in bits per second.
+	CANBaudRate int
 )
 
 const (
@@ -23,35 +31,102 @@ const (
 	CANBaudRate5k   CANBaudRateID = 0x09
 )
 
+// canBaudRatePrefix is the prefix of the CAN baud rate ID names, trimmed from their text representation.
+const canBaudRatePrefix = "CANBaudRate"
+
+// canBaudRates maps each CAN baud rate ID to its CAN baud rate.
+var canBaudRates = map[CANBaudRateID]CANBaudRate{
+	CANBaudRate1M:   1000000,
+	CANBaudRate800k: 800000,
+	CANBaudRate500k: 500000,
+	CANBaudRate250k: 250000,
+	CANBaudRate125k: 125000,
+	CANBaudRate100k: 100000,
+	CANBaudRate83k3: 83300,
+	CANBaudRate62k5: 62500,
+	CANBaudRate50k:  50000,
+	CANBaudRate33k3: 33300,
+	CANBaudRate20k:  20000,
+	CANBaudRate10k:  10000,
+	CANBaudRate5k:   5000,
+}
+
+// BaudRate returns the CAN baud rate identified by the ID.
+func (c CANBaudRateID) BaudRate() (CANBaudRate, error) {
+	if baudRate, ok := canBaudRates[c]; ok {
+		return baudRate, nil
+	}
+	return 0, fmt.Errorf("unknown CAN baud rate ID: %#x", uint8(c))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+//
+// The text representation is the human-friendly CAN baud rate, such as "500k", or the numeric ID for unknown IDs.
+func (c CANBaudRateID) MarshalText() ([]byte, error) {
+	baudRate, err := c.BaudRate()
+	if err != nil {
+		return []byte(strconv.Itoa(int(c))), nil
+	}
+	return baudRate.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+//
+// Accepts the human-friendly CAN baud rate, such as "500k", or the CAN baud rate in bits per second.
+func (c *CANBaudRateID) UnmarshalText(text []byte) error {
+	var baudRate CANBaudRate
+	if err := baudRate.UnmarshalText(text); err != nil {
+		return err
+	}
+	id, err := baudRate.ID()
+	if err != nil {
+		return err
+	}
+	*c = id
+	return nil
+}
+
+// ID returns the ID of the CAN baud rate, or -1 if the CAN baud rate is not supported.
 func (c CANBaudRate) ID() (CANBaudRateID, error) {
-	switch c {
-	case 5000:
-		return CANBaudRate5k, nil
-	case 10000:
-		return CANBaudRate10k, nil
-	case 20000:
-		return CANBaudRate20k, nil
-	case 33300:
-		return CANBaudRate33k3, nil
-	case 50000:
-		return CANBaudRate50k, nil
-	case 62500:
-		return CANBaudRate62k5, nil
-	case 83300:
-		return CANBaudRate83k3, nil
-	case 100000:
-		return CANBaudRate100k, nil
-	case 125000:
-		return CANBaudRate125k, nil
-	case 250000:
-		return CANBaudRate250k, nil
-	case 500000:
-		return CANBaudRate500k, nil
-	case 800000:
-		return CANBaudRate800k, nil
-	case 1000000:
-		return CANBaudRate1M, nil
-	default:
-		return -1, nil
+	for id, baudRate := range canBaudRates {
+		if baudRate == c {
+			return id, nil
+		}
+	}
+	return -1, nil
+}
+
+// String returns the human-friendly representation of the CAN baud rate, such as "500k".
+func (c CANBaudRate) String() string {
+	id, _ := c.ID()
+	if id == -1 {
+		return strconv.Itoa(int(c))
+	}
+	return strings.TrimPrefix(id.String(), canBaudRatePrefix)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c CANBaudRate) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+//
+// Accepts the human-friendly CAN baud rate, such as "500k", or the CAN baud rate in bits per second.
+func (c *CANBaudRate) UnmarshalText(text []byte) error {
+	for id, baudRate := range canBaudRates {
+		if strings.TrimPrefix(id.String(), canBaudRatePrefix) == string(text) {
+			*c = baudRate
+			return nil
+		}
+	}
+	baudRate, err := strconv.Atoi(string(text))
+	if err != nil {
+		return fmt.Errorf("unknown CAN baud rate: %s", text)
+	}
+	if id, _ := CANBaudRate(baudRate).ID(); id == -1 {
+		return fmt.Errorf("unsupported CAN baud rate: %d", baudRate)
 	}
+	*c = CANBaudRate(baudRate)
+	return nil
 }
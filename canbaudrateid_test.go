@@ -0,0 +1,55 @@
+package xsens
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCANBaudRateID_BaudRate(t *testing.T) {
+	for id, baudRate := range canBaudRates {
+		id, baudRate := id, baudRate
+		t.Run(id.String(), func(t *testing.T) {
+			actualBaudRate, err := id.BaudRate()
+			assert.NilError(t, err)
+			assert.Equal(t, baudRate, actualBaudRate)
+			actualID, err := baudRate.ID()
+			assert.NilError(t, err)
+			assert.Equal(t, id, actualID)
+		})
+	}
+	_, err := CANBaudRateID(0x7f).BaudRate()
+	assert.ErrorContains(t, err, "unknown CAN baud rate ID")
+	id, err := CANBaudRate(12345).ID()
+	assert.NilError(t, err)
+	assert.Equal(t, CANBaudRateID(-1), id)
+}
+
+func TestCANBaudRateID_Text(t *testing.T) {
+	for _, tt := range []struct {
+		text     string
+		expected CANBaudRateID
+	}{
+		{text: "1M", expected: CANBaudRate1M},
+		{text: "500k", expected: CANBaudRate500k},
+		{text: "83k3", expected: CANBaudRate83k3},
+		{text: "250000", expected: CANBaudRate250k},
+	} {
+		tt := tt
+		t.Run(tt.text, func(t *testing.T) {
+			var actual CANBaudRateID
+			assert.NilError(t, actual.UnmarshalText([]byte(tt.text)))
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+	text, err := CANBaudRate500k.MarshalText()
+	assert.NilError(t, err)
+	assert.Equal(t, "500k", string(text))
+	text, err = CANBaudRateID(0x7f).MarshalText()
+	assert.NilError(t, err)
+	assert.Equal(t, "127", string(text))
+	var id CANBaudRateID
+	assert.ErrorContains(t, id.UnmarshalText([]byte("42k")), "unknown CAN baud rate")
+	assert.ErrorContains(t, id.UnmarshalText([]byte("12345")), "unsupported CAN baud rate")
+	assert.Equal(t, "12345", CANBaudRate(12345).String())
+}
@@ -1,6 +1,7 @@
 package xsens
 
 import (
+	"encoding/json"
 	"fmt"
 )
 
@@ -9,6 +10,9 @@ type CANConfig struct {
 	BaudRate CANBaudRateID
 }
 
+// canConfigJSON is the JSON representation of the CAN configuration.
+type canConfigJSON CANConfig
+
 const (
 	canCfgEnableOffset   = 2
 	canCfgBaudrateOffset = 3
@@ -28,10 +32,19 @@ func (o *CANConfig) MarshalBinary() ([]byte, error) {
 
 // MarshalText returns a text representation of the CAN configuration.
 func (o *CANConfig) MarshalText() ([]byte, error) {
-	s := fmt.Sprintf("Enable: %v, BaudRate: %v\n", o.Enable, o.BaudRate)
+	var baudRate fmt.Stringer = o.BaudRate
+	if b, err := o.BaudRate.BaudRate(); err == nil {
+		baudRate = b
+	}
+	s := fmt.Sprintf("Enable: %v, BaudRate: %v\n", o.Enable, baudRate)
 	return []byte(s), nil
 }
 
+// MarshalJSON returns a JSON representation of the CAN configuration, with a human-friendly baud rate.
+func (o *CANConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal((*canConfigJSON)(o))
+}
+
 // UnmarshalBinary sets *o from a wire representation of the CAN configuration.
 func (o *CANConfig) UnmarshalBinary(data []byte) error {
 	if o == nil {
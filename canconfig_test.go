@@ -0,0 +1,64 @@
+package xsens
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCANConfig_JSON(t *testing.T) {
+	expected := &CANConfig{Enable: true, BaudRate: CANBaudRate500k}
+	js, err := json.Marshal(expected)
+	assert.NilError(t, err)
+	assert.Equal(t, `{"Enable":true,"BaudRate":"500k"}`, string(js))
+	var actual CANConfig
+	assert.NilError(t, json.Unmarshal(js, &actual))
+	assert.DeepEqual(t, expected, &actual)
+	assert.NilError(t, json.Unmarshal([]byte(`{"Enable":true,"BaudRate":"1000000"}`), &actual))
+	assert.Equal(t, CANBaudRate1M, actual.BaudRate)
+	txt, err := actual.MarshalText()
+	assert.NilError(t, err)
+	assert.Equal(t, "Enable: true, BaudRate: 1M\n", string(txt))
+}
+
+func TestCANOutputConfiguration_JSON(t *testing.T) {
+	expected := CANOutputConfiguration{
+		{CANDataIdentifier: CANDataIdentifierQuaternion, OutputFrequency: 100},
+		{
+			CANDataIdentifier: CANDataIdentifierLatLong,
+			CANIDLengthFlag:   CANIDLengthFlag29bits,
+			IDMask:            0x71,
+			OutputFrequency:   10,
+		},
+	}
+	js, err := json.Marshal(&expected)
+	assert.NilError(t, err)
+	assert.Equal(
+		t,
+		`[{"CANDataIdentifier":"Quaternion","CANIDLengthFlag":false,"IDMask":0,"OutputFrequency":100},`+
+			`{"CANDataIdentifier":"LatLong","CANIDLengthFlag":true,"IDMask":113,"OutputFrequency":10}]`,
+		string(js),
+	)
+	var actual CANOutputConfiguration
+	assert.NilError(t, json.Unmarshal(js, &actual))
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestCANOutputConfiguration_MarshalBinary(t *testing.T) {
+	configuration := CANOutputConfiguration{
+		{CANDataIdentifier: CANDataIdentifierQuaternion, OutputFrequency: 100},
+		{CANDataIdentifier: CANDataIdentifierLatLong, CANIDLengthFlag: CANIDLengthFlag29bits, OutputFrequency: 10},
+	}
+	data, err := configuration.MarshalBinary()
+	assert.NilError(t, err)
+	var actual CANOutputConfiguration
+	assert.NilError(t, actual.UnmarshalBinary(data))
+	assert.Equal(t, len(configuration), len(actual))
+	for i := range configuration {
+		assert.Equal(t, configuration[i].CANDataIdentifier, actual[i].CANDataIdentifier)
+		assert.Equal(t, configuration[i].CANIDLengthFlag, actual[i].CANIDLengthFlag)
+		assert.Equal(t, configuration[i].DefaultIDMask(), actual[i].IDMask)
+		assert.Equal(t, configuration[i].OutputFrequency, actual[i].OutputFrequency)
+	}
+}
@@ -36,6 +36,11 @@ const (
 	CANDataIdentifierGnssReceiverDop    CANDataIdentifier = 0x7A
 )
 
+// MarshalText implements encoding.TextMarshaler.
+func (i CANDataIdentifier) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
 func (i *CANDataIdentifier) UnmarshalText(text []byte) error {
 	knownIDs := []CANDataIdentifier{
 		CANDataIdentifierInvalid,
@@ -3,6 +3,7 @@ package xsens
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 )
 
@@ -127,6 +128,11 @@ func (o *CANOutputConfiguration) MarshalText() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// MarshalJSON returns a JSON representation of the CAN output configuration.
+func (o *CANOutputConfiguration) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]CANOutputConfigurationSetting(*o))
+}
+
 // UnmarshalBinary sets *o from a wire representation of the CAN output configuration.
 func (o *CANOutputConfiguration) UnmarshalBinary(data []byte) error {
 	settingsCount := len(data) / canOutputCfgSettingSize
@@ -1,20 +1,39 @@
 package xsens
 
 import (
-	"bufio"
 	"context"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"sync"
+	"time"
 )
 
+// ErrInvalidMessage is the error of a received message that fails validation, such as a message with an invalid
+// checksum. The client can keep receiving after an invalid message.
+var ErrInvalidMessage = errors.New("invalid message")
+
 // Client for communicating with an Xsens device.
+//
+// Pending reads and writes are aborted when the context passed to a method is done. Ports that support deadlines,
+// such as net.Conn, are aborted by setting a deadline, which is cleared when the I/O completes. For other ports, I/O
+// is performed in a goroutine. In both cases, data read before or after an aborted receive is kept for the next
+// receive.
+//
+// A client is not safe for concurrent use, except for its request methods while streaming, see Stream.
 type Client struct {
-	p               io.ReadWriteCloser
-	sc              *bufio.Scanner
+	p               *contextPort
+	writeMu         sync.Mutex
+	mu              sync.Mutex
+	stream          *Stream
+	sc              *messageScanner
 	message         Message
 	mtData2         MTData2
 	mtData2Packet   MTData2Packet
 	nextPacketIndex int
+	scanErr         error
 	// measurement data
 	packetCounter     PacketCounter
 	statusByte        StatusByte
@@ -41,18 +60,26 @@ type Client struct {
 	magneticField     MagneticField
 	gnssPVTData       GNSSPVTData
 	gnssSatInfo       GNSSSatInfo
+	itow              ITOW
+	gpsAge            GPSAge
+	pressureAge       PressureAge
+	gpsDOP            GPSDOP
+	gpsSOL            GPSSOL
+	gpsTimeUTC        GPSTimeUTC
+	gpsSVInfo         GPSSVInfo
+	rawMeasurement    RawMeasurement
 }
 
 // NewClient returns a new client using the provided ReadWriterCloser for communication.
 func NewClient(p io.ReadWriteCloser) *Client {
-	sc := bufio.NewScanner(p)
-	sc.Split(ScanMessages)
-	return &Client{p: p, sc: sc}
+	c := &Client{p: &contextPort{p: p}}
+	c.sc = newMessageScanner(c.p)
+	return c
 }
 
 // Close the client's ReadWriterCloser.
 func (c *Client) Close() error {
-	if err := c.p.Close(); err != nil {
+	if err := c.p.p.Close(); err != nil {
 		return fmt.Errorf("xsens client: close: %w", err)
 	}
 	return nil
@@ -61,22 +88,31 @@ func (c *Client) Close() error {
 // Receive an Xsens message.
 //
 // Clears state related to a previously received message, such as scanned measurement data.
-func (c *Client) Receive(_ context.Context) error {
+//
+// Returns the context's error, wrapped, if the context is done before a message has been received, and
+// ErrInvalidMessage, wrapped, if the received message fails validation.
+func (c *Client) Receive(ctx context.Context) error {
 	// clear previous received message state
 	c.message = nil
 	c.mtData2 = nil
 	c.mtData2Packet = nil
 	c.nextPacketIndex = 0
+	c.scanErr = nil
 	// receive new message
+	c.p.readCtx = ctx
+	defer func() {
+		c.p.readCtx = nil
+	}()
 	if !c.sc.Scan() {
-		if c.sc.Err() == nil {
+		err := c.sc.Err()
+		if err == nil {
 			return fmt.Errorf("xsens client: receive: %w", io.EOF)
 		}
-		return fmt.Errorf("xsens client: receive: %w", c.sc.Err())
+		return fmt.Errorf("xsens client: receive: %w", err)
 	}
 	c.message = c.sc.Bytes()
 	if err := c.message.Validate(); err != nil {
-		return fmt.Errorf("xsens client: receive: %w", c.sc.Err())
+		return fmt.Errorf("xsens client: receive: %w: %v", ErrInvalidMessage, err)
 	}
 	if c.message.Identifier() == MessageIdentifierMTData2 {
 		c.mtData2 = c.message.Data()
@@ -91,10 +127,8 @@ func (c *Client) RawMessage() []byte {
 
 // GoToConfig puts the Xsens device in config mode.
 func (c *Client) GoToConfig(ctx context.Context) error {
-	if err := c.send(ctx, NewMessage(MessageIdentifierGotoConfig, nil)); err != nil {
-		return fmt.Errorf("xsens client: go to config: %w", err)
-	}
-	if err := c.receiveUntil(ctx, MessageIdentifierGotoConfigAck); err != nil {
+	req := NewMessage(MessageIdentifierGotoConfig, nil)
+	if _, err := c.request(ctx, req, MessageIdentifierGotoConfigAck); err != nil {
 		return fmt.Errorf("xsens client: go to config: %w", err)
 	}
 	return nil
@@ -106,10 +140,8 @@ func (c *Client) SetOutputConfiguration(ctx context.Context, configuration Outpu
 	if err != nil {
 		return fmt.Errorf("xsens client: set output configuration: %w", err)
 	}
-	if err := c.send(ctx, NewMessage(MessageIdentifierSetOutputConfiguration, data)); err != nil {
-		return fmt.Errorf("xsens client: set output configuration: %w", err)
-	}
-	if err := c.receiveUntil(ctx, MessageIdentifierSetOutputConfigurationAck); err != nil {
+	req := NewMessage(MessageIdentifierSetOutputConfiguration, data)
+	if _, err := c.request(ctx, req, MessageIdentifierSetOutputConfigurationAck); err != nil {
 		return fmt.Errorf("xsens client: set output configuration: %w", err)
 	}
 	return nil
@@ -117,14 +149,13 @@ func (c *Client) SetOutputConfiguration(ctx context.Context, configuration Outpu
 
 // GetOutputConfiguration returns the Xsens output configuration.
 func (c *Client) GetOutputConfiguration(ctx context.Context) (OutputConfiguration, error) {
-	if err := c.send(ctx, NewMessage(MessageIdentifierReqOutputConfiguration, nil)); err != nil {
-		return nil, fmt.Errorf("xsens client: get output configuration: %w", err)
-	}
-	if err := c.receiveUntil(ctx, MessageIdentifierReqOutputConfigurationAck); err != nil {
+	req := NewMessage(MessageIdentifierReqOutputConfiguration, nil)
+	response, err := c.request(ctx, req, MessageIdentifierReqOutputConfigurationAck)
+	if err != nil {
 		return nil, fmt.Errorf("xsens client: get output configuration: %w", err)
 	}
 	var result OutputConfiguration
-	if err := result.Unmarshal(c.message.Data()); err != nil {
+	if err := result.Unmarshal(response.Data()); err != nil {
 		return nil, fmt.Errorf("xsens client: get output configuration: %w", err)
 	}
 	return result, nil
@@ -136,10 +167,8 @@ func (c *Client) SetCANOutputConfiguration(ctx context.Context, configuration CA
 	if err != nil {
 		return fmt.Errorf("xsens client: set CAN output configuration: %w", err)
 	}
-	if err := c.send(ctx, NewMessage(MessageIdentifierSetCANOutputConfig, data)); err != nil {
-		return fmt.Errorf("xsens client: set CAN output configuration: %w", err)
-	}
-	if err := c.receiveUntil(ctx, MessageIdentifierSetCANOutputConfigAck); err != nil {
+	req := NewMessage(MessageIdentifierSetCANOutputConfig, data)
+	if _, err := c.request(ctx, req, MessageIdentifierSetCANOutputConfigAck); err != nil {
 		return fmt.Errorf("xsens client: set CAN output configuration: %w", err)
 	}
 	return nil
@@ -147,14 +176,13 @@ func (c *Client) SetCANOutputConfiguration(ctx context.Context, configuration CA
 
 // GetCANOutputConfiguration returns the Xsens CAN output configuration.
 func (c *Client) GetCANOutputConfiguration(ctx context.Context) (CANOutputConfiguration, error) {
-	if err := c.send(ctx, NewMessage(MessageIdentifierReqCANOutputConfig, nil)); err != nil {
-		return nil, fmt.Errorf("xsens client: get output configuration: %w", err)
-	}
-	if err := c.receiveUntil(ctx, MessageIdentifierReqCANOutputConfigAck); err != nil {
+	req := NewMessage(MessageIdentifierReqCANOutputConfig, nil)
+	response, err := c.request(ctx, req, MessageIdentifierReqCANOutputConfigAck)
+	if err != nil {
 		return nil, fmt.Errorf("xsens client: get output configuration: %w", err)
 	}
 	var result CANOutputConfiguration
-	if err := result.UnmarshalBinary(c.message.Data()); err != nil {
+	if err := result.UnmarshalBinary(response.Data()); err != nil {
 		return nil, fmt.Errorf("xsens client: get CAN output configuration: %w", err)
 	}
 	return result, nil
@@ -166,10 +194,8 @@ func (c *Client) SetCANConfiguration(ctx context.Context, configuration CANConfi
 	if err != nil {
 		return fmt.Errorf("xsens client: set CAN configuration: %w", err)
 	}
-	if err := c.send(ctx, NewMessage(MessageIdentifierSetCANConfig, data)); err != nil {
-		return fmt.Errorf("xsens client: set CAN output configuration: %w", err)
-	}
-	if err := c.receiveUntil(ctx, MessageIdentifierSetCANConfigAck); err != nil {
+	req := NewMessage(MessageIdentifierSetCANConfig, data)
+	if _, err := c.request(ctx, req, MessageIdentifierSetCANConfigAck); err != nil {
 		return fmt.Errorf("xsens client: set CAN configuration: %w", err)
 	}
 	return nil
@@ -177,14 +203,13 @@ func (c *Client) SetCANConfiguration(ctx context.Context, configuration CANConfi
 
 // GetCANConfiguration returns the Xsens CAN output configuration.
 func (c *Client) GetCANConfiguration(ctx context.Context) (*CANConfig, error) {
-	if err := c.send(ctx, NewMessage(MessageIdentifierReqCANConfig, nil)); err != nil {
-		return nil, fmt.Errorf("xsens client: get configuration: %w", err)
-	}
-	if err := c.receiveUntil(ctx, MessageIdentifierReqCANConfigAck); err != nil {
+	req := NewMessage(MessageIdentifierReqCANConfig, nil)
+	response, err := c.request(ctx, req, MessageIdentifierReqCANConfigAck)
+	if err != nil {
 		return nil, fmt.Errorf("xsens client: get configuration: %w", err)
 	}
 	result := &CANConfig{}
-	if err := result.UnmarshalBinary(c.message.Data()); err != nil {
+	if err := result.UnmarshalBinary(response.Data()); err != nil {
 		return nil, fmt.Errorf("xsens client: get CAN configuration: %w", err)
 	}
 	return result, nil
@@ -192,14 +217,13 @@ func (c *Client) GetCANConfiguration(ctx context.Context) (*CANConfig, error) {
 
 // GetDeviceID returns the Xsens DeviceID.
 func (c *Client) GetDeviceID(ctx context.Context) (*DeviceID, error) {
-	if err := c.send(ctx, NewMessage(MessageIdentifierReqDID, nil)); err != nil {
-		return nil, fmt.Errorf("xsens client: get device id: %w", err)
-	}
-	if err := c.receiveUntil(ctx, MessageIdentifierDeviceID); err != nil {
+	req := NewMessage(MessageIdentifierReqDID, nil)
+	response, err := c.request(ctx, req, MessageIdentifierDeviceID)
+	if err != nil {
 		return nil, fmt.Errorf("xsens client: get device id: %w", err)
 	}
 	result := DeviceID(0)
-	if err := (&result).UnmarshalBinary(c.message.Data()); err != nil {
+	if err := (&result).UnmarshalBinary(response.Data()); err != nil {
 		return nil, fmt.Errorf("xsens client: get device id: %w", err)
 	}
 	return &result, nil
@@ -207,14 +231,13 @@ func (c *Client) GetDeviceID(ctx context.Context) (*DeviceID, error) {
 
 // GetProductCode returns the Xsens ProductCode.
 func (c *Client) GetProductCode(ctx context.Context) (*ProductCode, error) {
-	if err := c.send(ctx, NewMessage(MessageIdentifierReqProductCode, nil)); err != nil {
-		return nil, fmt.Errorf("xsens client: get device id: %w", err)
-	}
-	if err := c.receiveUntil(ctx, MessageIdentifierProductCode); err != nil {
+	req := NewMessage(MessageIdentifierReqProductCode, nil)
+	response, err := c.request(ctx, req, MessageIdentifierProductCode)
+	if err != nil {
 		return nil, fmt.Errorf("xsens client: get device id: %w", err)
 	}
 	result := ProductCode("")
-	if err := (&result).UnmarshalBinary(c.message.Data()); err != nil {
+	if err := (&result).UnmarshalBinary(response.Data()); err != nil {
 		return nil, fmt.Errorf("xsens client: get device id: %w", err)
 	}
 	return &result, nil
@@ -222,25 +245,383 @@ func (c *Client) GetProductCode(ctx context.Context) (*ProductCode, error) {
 
 // GetHWVersion returns the Xsens HWVersion.
 func (c *Client) GetHWVersion(ctx context.Context) (*HWVersion, error) {
-	if err := c.send(ctx, NewMessage(MessageIdentifierReqHWVersion, nil)); err != nil {
-		return nil, fmt.Errorf("xsens client: get device id: %w", err)
-	}
-	if err := c.receiveUntil(ctx, MessageIdentifierHWVersion); err != nil {
+	req := NewMessage(MessageIdentifierReqHWVersion, nil)
+	response, err := c.request(ctx, req, MessageIdentifierHWVersion)
+	if err != nil {
 		return nil, fmt.Errorf("xsens client: get device id: %w", err)
 	}
 	result := HWVersion("")
-	if err := (&result).UnmarshalBinary(c.message.Data()); err != nil {
+	if err := (&result).UnmarshalBinary(response.Data()); err != nil {
 		return nil, fmt.Errorf("xsens client: get device id: %w", err)
 	}
 	return &result, nil
 }
 
+// GetFirmwareRevision returns the Xsens device's firmware revision.
+func (c *Client) GetFirmwareRevision(ctx context.Context) (*FirmwareRevision, error) {
+	req := NewMessage(MessageIdentifierReqFirmwareRevision, nil)
+	response, err := c.request(ctx, req, MessageIdentifierFirmwareRevision)
+	if err != nil {
+		return nil, fmt.Errorf("xsens client: get firmware revision: %w", err)
+	}
+	result := &FirmwareRevision{}
+	if err := result.UnmarshalBinary(response.Data()); err != nil {
+		return nil, fmt.Errorf("xsens client: get firmware revision: %w", err)
+	}
+	return result, nil
+}
+
+// RunSelfTest runs the Xsens device's built-in self test and returns the results.
+//
+// The device must be in config mode and should be kept still during the self test.
+func (c *Client) RunSelfTest(ctx context.Context) (SelfTestResults, error) {
+	req := NewMessage(MessageIdentifierRunSelfTest, nil)
+	response, err := c.request(ctx, req, MessageIdentifierSelfTestResults)
+	if err != nil {
+		return 0, fmt.Errorf("xsens client: run self test: %w", err)
+	}
+	var result SelfTestResults
+	if err := result.UnmarshalBinary(response.Data()); err != nil {
+		return 0, fmt.Errorf("xsens client: run self test: %w", err)
+	}
+	return result, nil
+}
+
+// GetDeviceInfo returns the Xsens device's identification, firmware revision and self test results.
+//
+// The device must be in config mode, see RunSelfTest.
+func (c *Client) GetDeviceInfo(ctx context.Context) (*DeviceInfo, error) {
+	deviceID, err := c.GetDeviceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	productCode, err := c.GetProductCode(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hwVersion, err := c.GetHWVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	firmwareRevision, err := c.GetFirmwareRevision(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selfTestResults, err := c.RunSelfTest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceInfo{
+		DeviceID:         *deviceID,
+		ProductCode:      *productCode,
+		HWVersion:        *hwVersion,
+		FirmwareRevision: *firmwareRevision,
+		SelfTestResults:  selfTestResults,
+	}, nil
+}
+
+// GetAvailableFilterProfiles returns the filter profiles available on the Xsens device.
+func (c *Client) GetAvailableFilterProfiles(ctx context.Context) (FilterProfiles, error) {
+	req := NewMessage(MessageIdentifierReqAvailableFilterProfiles, nil)
+	response, err := c.request(ctx, req, MessageIdentifierAvailableFilterProfiles)
+	if err != nil {
+		return nil, fmt.Errorf("xsens client: get available filter profiles: %w", err)
+	}
+	var result FilterProfiles
+	if err := result.UnmarshalBinary(response.Data()); err != nil {
+		return nil, fmt.Errorf("xsens client: get available filter profiles: %w", err)
+	}
+	return result, nil
+}
+
+// GetFilterProfile returns the number and version of the Xsens device's current filter profile.
+//
+// The label of the filter profile is not provided by the device, see GetAvailableFilterProfiles.
+func (c *Client) GetFilterProfile(ctx context.Context) (*FilterProfile, error) {
+	req := NewMessage(MessageIdentifierReqFilterProfile, nil)
+	response, err := c.request(ctx, req, MessageIdentifierReqFilterProfileAck)
+	if err != nil {
+		return nil, fmt.Errorf("xsens client: get filter profile: %w", err)
+	}
+	result := &FilterProfile{}
+	if err := result.unmarshalFilterProfileID(response.Data()); err != nil {
+		return nil, fmt.Errorf("xsens client: get filter profile: %w", err)
+	}
+	return result, nil
+}
+
+// SetFilterProfile sets the Xsens device's filter profile, identified by its number.
+func (c *Client) SetFilterProfile(ctx context.Context, profile FilterProfile) error {
+	req := NewMessage(MessageIdentifierSetFilterProfile, profile.marshalFilterProfileID())
+	if _, err := c.request(ctx, req, MessageIdentifierSetFilterProfileAck); err != nil {
+		return fmt.Errorf("xsens client: set filter profile: %w", err)
+	}
+	return nil
+}
+
+// GetAlignmentRotation returns the Xsens device's alignment rotation for the provided frame.
+func (c *Client) GetAlignmentRotation(ctx context.Context, frame AlignmentFrame) (*Quaternion, error) {
+	req := NewMessage(MessageIdentifierReqAlignmentRotation, []byte{uint8(frame)})
+	response, err := c.request(ctx, req, MessageIdentifierReqAlignmentRotationAck)
+	if err != nil {
+		return nil, fmt.Errorf("xsens client: get alignment rotation: %w", err)
+	}
+	var result AlignmentRotation
+	if err := result.UnmarshalBinary(response.Data()); err != nil {
+		return nil, fmt.Errorf("xsens client: get alignment rotation: %w", err)
+	}
+	if result.Frame != frame {
+		return nil, fmt.Errorf(
+			"xsens client: get alignment rotation: unexpected frame: want: %v, got: %v", frame, result.Frame,
+		)
+	}
+	return &result.Quaternion, nil
+}
+
+// SetAlignmentRotation sets the Xsens device's alignment rotation for the provided frame.
+//
+// The sensor frame rotation aligns the output with the object the device is mounted on, while the local frame
+// rotation redefines the local tangent plane the orientation is expressed in.
+func (c *Client) SetAlignmentRotation(ctx context.Context, frame AlignmentFrame, q Quaternion) error {
+	data, err := (&AlignmentRotation{Frame: frame, Quaternion: q}).MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("xsens client: set alignment rotation: %w", err)
+	}
+	req := NewMessage(MessageIdentifierSetAlignmentRotation, data)
+	if _, err := c.request(ctx, req, MessageIdentifierSetAlignmentRotationAck); err != nil {
+		return fmt.Errorf("xsens client: set alignment rotation: %w", err)
+	}
+	return nil
+}
+
+// GetGNSSLeverArm returns the Xsens device's GNSS lever arm, the position of the GNSS antenna in the sensor frame
+// in meters.
+func (c *Client) GetGNSSLeverArm(ctx context.Context) (*VectorXYZ, error) {
+	req := NewMessage(MessageIdentifierReqGpsLeverArm, nil)
+	response, err := c.request(ctx, req, MessageIdentifierReqGpsLeverArmAck)
+	if err != nil {
+		return nil, fmt.Errorf("xsens client: get gnss lever arm: %w", err)
+	}
+	result, err := unmarshalLeverArm(response.Data())
+	if err != nil {
+		return nil, fmt.Errorf("xsens client: get gnss lever arm: %w", err)
+	}
+	return &result, nil
+}
+
+// SetGNSSLeverArm sets the Xsens device's GNSS lever arm, the position of the GNSS antenna in the sensor frame
+// in meters.
+func (c *Client) SetGNSSLeverArm(ctx context.Context, leverArm VectorXYZ) error {
+	req := NewMessage(MessageIdentifierSetGpsLeverArm, marshalLeverArm(leverArm))
+	if _, err := c.request(ctx, req, MessageIdentifierSetGpsLeverArmAck); err != nil {
+		return fmt.Errorf("xsens client: set gnss lever arm: %w", err)
+	}
+	return nil
+}
+
+// GetLatLonAlt returns the Xsens device's initial position.
+func (c *Client) GetLatLonAlt(ctx context.Context) (*LatLonAlt, error) {
+	req := NewMessage(MessageIdentifierReqLatLonAlt, nil)
+	response, err := c.request(ctx, req, MessageIdentifierReqLatLonAltAck)
+	if err != nil {
+		return nil, fmt.Errorf("xsens client: get lat lon alt: %w", err)
+	}
+	var result LatLonAlt
+	if err := result.UnmarshalBinary(response.Data()); err != nil {
+		return nil, fmt.Errorf("xsens client: get lat lon alt: %w", err)
+	}
+	return &result, nil
+}
+
+// SetLatLonAlt sets the Xsens device's initial position, used by the filter until a GNSS fix is available.
+func (c *Client) SetLatLonAlt(ctx context.Context, position LatLonAlt) error {
+	data, err := position.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("xsens client: set lat lon alt: %w", err)
+	}
+	req := NewMessage(MessageIdentifierSetLatLonAlt, data)
+	if _, err := c.request(ctx, req, MessageIdentifierSetLatLonAltAck); err != nil {
+		return fmt.Errorf("xsens client: set lat lon alt: %w", err)
+	}
+	return nil
+}
+
+// GetBaudRate returns the Xsens device's serial baud rate.
+func (c *Client) GetBaudRate(ctx context.Context) (BaudRate, error) {
+	req := NewMessage(MessageIdentifierReqBaudrate, nil)
+	response, err := c.request(ctx, req, MessageIdentifierReqBaudrateAck)
+	if err != nil {
+		return 0, fmt.Errorf("xsens client: get baud rate: %w", err)
+	}
+	if l := len(response.Data()); l != 1 {
+		return 0, fmt.Errorf("xsens client: get baud rate: unexpected length: want: %d, got: %d", 1, l)
+	}
+	result, err := BaudRateID(response.Data()[0]).BaudRate()
+	if err != nil {
+		return 0, fmt.Errorf("xsens client: get baud rate: %w", err)
+	}
+	return result, nil
+}
+
+// SetBaudRate sets the Xsens device's serial baud rate.
+//
+// The new baud rate takes effect after the device is reset.
+func (c *Client) SetBaudRate(ctx context.Context, baudRate BaudRate) error {
+	id, err := baudRate.ID()
+	if err != nil {
+		return fmt.Errorf("xsens client: set baud rate: %w", err)
+	}
+	req := NewMessage(MessageIdentifierSetBaudrate, []byte{uint8(id)})
+	if _, err := c.request(ctx, req, MessageIdentifierSetBaudrateAck); err != nil {
+		return fmt.Errorf("xsens client: set baud rate: %w", err)
+	}
+	return nil
+}
+
+// GetSyncSettings returns the Xsens device's sync settings.
+func (c *Client) GetSyncSettings(ctx context.Context) (SyncSettings, error) {
+	req := NewMessage(MessageIdentifierReqSyncConfiguration, nil)
+	response, err := c.request(ctx, req, MessageIdentifierSyncConfiguration)
+	if err != nil {
+		return nil, fmt.Errorf("xsens client: get sync settings: %w", err)
+	}
+	var result SyncSettings
+	if err := result.UnmarshalBinary(response.Data()); err != nil {
+		return nil, fmt.Errorf("xsens client: get sync settings: %w", err)
+	}
+	return result, nil
+}
+
+// SetSyncSettings sets the Xsens device's sync settings, replacing all previously configured settings.
+//
+// An empty list of settings disables all sync line functions.
+func (c *Client) SetSyncSettings(ctx context.Context, settings SyncSettings) error {
+	data, err := settings.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("xsens client: set sync settings: %w", err)
+	}
+	req := NewMessage(MessageIdentifierSetSyncConfiguration, data)
+	if _, err := c.request(ctx, req, MessageIdentifierSetSyncConfigurationAck); err != nil {
+		return fmt.Errorf("xsens client: set sync settings: %w", err)
+	}
+	return nil
+}
+
+// GetUTCTime returns the Xsens device's UTC time.
+func (c *Client) GetUTCTime(ctx context.Context) (*UTCTime, error) {
+	req := NewMessage(MessageIdentifierReqUtcTime, nil)
+	response, err := c.request(ctx, req, MessageIdentifierUtcTime)
+	if err != nil {
+		return nil, fmt.Errorf("xsens client: get utc time: %w", err)
+	}
+	result := &UTCTime{}
+	if err := result.UnmarshalBinary(response.Data()); err != nil {
+		return nil, fmt.Errorf("xsens client: get utc time: %w", err)
+	}
+	return result, nil
+}
+
+// SetUTCTime sets the Xsens device's UTC time.
+func (c *Client) SetUTCTime(ctx context.Context, t time.Time) error {
+	utcTime := UTCTime{Valid: UTCDateValidFlag | UTCTimeOfDayValidFlag}
+	utcTime.UnmarshalTime(t)
+	data, err := utcTime.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("xsens client: set utc time: %w", err)
+	}
+	req := NewMessage(MessageIdentifierSetUtcTime, data)
+	if _, err := c.request(ctx, req, MessageIdentifierSetUtcTimeAck); err != nil {
+		return fmt.Errorf("xsens client: set utc time: %w", err)
+	}
+	return nil
+}
+
+// AdjustUTCTime adjusts the Xsens device's UTC time by the provided duration, with nanosecond resolution.
+//
+// The adjustment is limited to the range of an int32 number of nanoseconds, about ±2.1 seconds. Use SetUTCTime
+// for larger adjustments.
+func (c *Client) AdjustUTCTime(ctx context.Context, d time.Duration) error {
+	if d < math.MinInt32 || d > math.MaxInt32 {
+		return fmt.Errorf("xsens client: adjust utc time: duration out of range: %v", d)
+	}
+	data := make([]byte, 4)
+	binary.BigEndian.PutUint32(data, uint32(int32(d)))
+	req := NewMessage(MessageIdentifierAdjustUtcTime, data)
+	if _, err := c.request(ctx, req, MessageIdentifierAdjustUtcTimeAck); err != nil {
+		return fmt.Errorf("xsens client: adjust utc time: %w", err)
+	}
+	return nil
+}
+
+// GetActiveClockCorrection returns the Xsens device's active clock correction.
+func (c *Client) GetActiveClockCorrection(ctx context.Context) (*ClockCorrection, error) {
+	req := NewMessage(MessageIdentifierReqActiveClockCorrection, nil)
+	response, err := c.request(ctx, req, MessageIdentifierActiveClockCorrection)
+	if err != nil {
+		return nil, fmt.Errorf("xsens client: get active clock correction: %w", err)
+	}
+	var result ClockCorrection
+	if err := result.UnmarshalBinary(response.Data()); err != nil {
+		return nil, fmt.Errorf("xsens client: get active clock correction: %w", err)
+	}
+	return &result, nil
+}
+
+// StoreActiveClockCorrection stores the Xsens device's active clock correction in non-volatile memory, to be used
+// at the next startup.
+func (c *Client) StoreActiveClockCorrection(ctx context.Context) error {
+	req := NewMessage(MessageIdentifierStoreActiveClockCorrection, nil)
+	if _, err := c.request(ctx, req, MessageIdentifierStoreActiveClockCorrectionAck); err != nil {
+		return fmt.Errorf("xsens client: store active clock correction: %w", err)
+	}
+	return nil
+}
+
+// maxNoRotationDuration is the longest duration of a no rotation update.
+const maxNoRotationDuration = 0xffff * time.Second
+
+// SetNoRotation starts a no rotation update, estimating the gyro bias while the device is kept still for the
+// provided duration, rounded up to whole seconds.
+//
+// The device must be in measurement mode. The progress of the no rotation update is reported by the
+// NoRotationUpdateStatus of the StatusWord.
+func (c *Client) SetNoRotation(ctx context.Context, duration time.Duration) error {
+	if duration < 0 || duration > maxNoRotationDuration {
+		return fmt.Errorf("xsens client: set no rotation: duration out of range: %v", duration)
+	}
+	seconds := uint16((duration + time.Second - 1) / time.Second)
+	req := NewMessage(MessageIdentifierSetNoRotation, []byte{uint8(seconds >> 8), uint8(seconds)})
+	if _, err := c.request(ctx, req, MessageIdentifierSetNoRotationAck); err != nil {
+		return fmt.Errorf("xsens client: set no rotation: %w", err)
+	}
+	return nil
+}
+
+// ResetOrientation performs an orientation reset of the Xsens device.
+//
+// Resets are performed in measurement mode, and stored with ResetOrientationCodeStore in config mode.
+func (c *Client) ResetOrientation(ctx context.Context, code ResetOrientationCode) error {
+	req := NewMessage(MessageIdentifierResetOrientation, []byte{uint8(code >> 8), uint8(code)})
+	if _, err := c.request(ctx, req, MessageIdentifierResetOrientationAck); err != nil {
+		return fmt.Errorf("xsens client: reset orientation: %w", err)
+	}
+	return nil
+}
+
+// StoreFilterState stores the current filter state, such as the estimated gyro bias, in the Xsens device's
+// non-volatile memory, to be used as the initial state at the next startup.
+func (c *Client) StoreFilterState(ctx context.Context) error {
+	req := NewMessage(MessageIdentifierStoreFilterState, nil)
+	if _, err := c.request(ctx, req, MessageIdentifierStoreFilterStateAck); err != nil {
+		return fmt.Errorf("xsens client: store filter state: %w", err)
+	}
+	return nil
+}
+
 // GoToMeasurement puts the Xsens device in measurement mode.
 func (c *Client) GoToMeasurement(ctx context.Context) error {
-	if err := c.send(ctx, NewMessage(MessageIdentifierGotoMeasurement, nil)); err != nil {
-		return fmt.Errorf("xsens client: go to measurement: %w", err)
-	}
-	if err := c.receiveUntil(ctx, MessageIdentifierMTData2); err != nil {
+	req := NewMessage(MessageIdentifierGotoMeasurement, nil)
+	if _, err := c.request(ctx, req, MessageIdentifierMTData2); err != nil {
 		return fmt.Errorf("xsens client: go to config: %w", err)
 	}
 	return nil
@@ -253,6 +634,9 @@ func (c *Client) MessageIdentifier() MessageIdentifier {
 
 // MeasurementData returns the last scanned measurement data.
 func (c *Client) MeasurementData() MeasurementData {
+	if len(c.mtData2Packet) == 0 {
+		return nil
+	}
 	switch c.mtData2Packet.Identifier().DataType {
 	case DataTypeDeltaV:
 		return &c.deltaV
@@ -304,30 +688,81 @@ func (c *Client) MeasurementData() MeasurementData {
 		return &c.gnssPVTData
 	case DataTypeGNSSSatInfo:
 		return &c.gnssSatInfo
-	}
-	return nil
+	case DataTypeITOW:
+		return &c.itow
+	case DataTypeGPSAge:
+		return &c.gpsAge
+	case DataTypePressureAge:
+		return &c.pressureAge
+	case DataTypeGPSDOP:
+		return &c.gpsDOP
+	case DataTypeGPSSOL:
+		return &c.gpsSOL
+	case DataTypeGPSTimeUTC:
+		return &c.gpsTimeUTC
+	case DataTypeGPSSVInfo:
+		return &c.gpsSVInfo
+	}
+	return &c.rawMeasurement
 }
 
 // ScanMeasurementData advances to the next measurement data packet, when the current message contains measurement data.
+//
+// Packets with unsupported data types are provided as a RawMeasurement. Packets that could not be decoded are
+// skipped, and Err returns the first decode error. Returns false when there are no more packets.
 func (c *Client) ScanMeasurementData() bool {
 	if c.message.Identifier() != MessageIdentifierMTData2 {
 		return false
 	}
-	packet, err := c.mtData2.PacketAt(c.nextPacketIndex)
-	if err != nil {
-		return false
+	for c.nextPacketIndex < len(c.mtData2) {
+		packet, err := c.mtData2.PacketAt(c.nextPacketIndex)
+		if err != nil {
+			c.setScanErr(fmt.Errorf("xsens client: scan measurement data: packet at %d: %w", c.nextPacketIndex, err))
+			// the boundaries of the remaining packets are unknown
+			c.nextPacketIndex = len(c.mtData2)
+			return false
+		}
+		c.nextPacketIndex += len(packet)
+		c.mtData2Packet = packet
+		if err := c.MeasurementData().UnmarshalMTData2Packet(c.mtData2Packet); err != nil {
+			c.setScanErr(fmt.Errorf("xsens client: scan measurement data: %v: %w", c.mtData2Packet.Identifier(), err))
+			continue
+		}
+		return true
 	}
-	c.nextPacketIndex += len(packet)
-	c.mtData2Packet = packet
-	// TODO: Improve this API after removing MessageScanner
-	data := c.MeasurementData()
-	if data == nil {
-		return false
+	return false
+}
+
+// setScanErr sets the scan error, unless an earlier error has been encountered for the current message.
+func (c *Client) setScanErr(err error) {
+	if c.scanErr == nil {
+		c.scanErr = err
 	}
-	if err := data.UnmarshalMTData2Packet(c.mtData2Packet); err != nil {
-		return false
+}
+
+// Err returns the first error that was encountered by ScanMeasurementData for the current message.
+func (c *Client) Err() error {
+	return c.scanErr
+}
+
+// Snapshot returns the measurement data of the current MTData2 message.
+//
+// The returned snapshot is independent of the client's state, and remains valid after the next Receive.
+//
+// When packets of the message could not be decoded, the snapshot of the remaining packets is returned together
+// with the first decode error.
+func (c *Client) Snapshot() (*Snapshot, error) {
+	if c.message == nil {
+		return nil, fmt.Errorf("xsens client: snapshot: no current message")
+	}
+	if c.message.Identifier() != MessageIdentifierMTData2 {
+		return nil, fmt.Errorf("xsens client: snapshot: current message is %v", c.message.Identifier())
+	}
+	var s Snapshot
+	if err := s.UnmarshalMTData2(c.mtData2); err != nil {
+		return &s, fmt.Errorf("xsens client: %w", err)
 	}
-	return true
+	return &s, nil
 }
 
 // RawPacket returns the raw bytes of the current measurement data packet.
@@ -432,18 +867,83 @@ func (c *Client) PositionECEF() *PositionECEF {
 	return &c.positionECEF
 }
 
-func (c *Client) send(_ context.Context, message Message) error {
+func (c *Client) ITOW() *ITOW {
+	return &c.itow
+}
+
+func (c *Client) GPSAge() *GPSAge {
+	return &c.gpsAge
+}
+
+func (c *Client) PressureAge() *PressureAge {
+	return &c.pressureAge
+}
+
+func (c *Client) GPSDOP() *GPSDOP {
+	return &c.gpsDOP
+}
+
+func (c *Client) GPSSOL() *GPSSOL {
+	return &c.gpsSOL
+}
+
+func (c *Client) GPSTimeUTC() *GPSTimeUTC {
+	return &c.gpsTimeUTC
+}
+
+func (c *Client) GPSSVInfo() *GPSSVInfo {
+	return &c.gpsSVInfo
+}
+
+// request sends a message and waits for the provided acknowledgement, which is returned.
+//
+// When the client is streaming, the acknowledgement is received by the stream. Otherwise, the returned message is
+// only valid until the next receive.
+func (c *Client) request(ctx context.Context, message Message, ack MessageIdentifier) (Message, error) {
+	if s := c.currentStream(); s != nil {
+		return s.request(ctx, message, ack)
+	}
+	if err := c.send(ctx, message); err != nil {
+		return nil, err
+	}
+	if err := c.receiveUntil(ctx, ack); err != nil {
+		return nil, err
+	}
+	return c.message, nil
+}
+
+// currentStream returns the client's current stream, or nil if the client is not streaming.
+func (c *Client) currentStream() *Stream {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stream
+}
+
+// send a message, safe for concurrent use.
+func (c *Client) send(ctx context.Context, message Message) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	c.p.writeCtx = ctx
+	defer func() {
+		c.p.writeCtx = nil
+	}()
 	if _, err := c.p.Write(message); err != nil {
 		return fmt.Errorf("send %v: %w", message.Identifier(), err)
 	}
 	return nil
 }
 
+// receiveUntil receives messages until a message with the provided identifier is received.
+//
+// Returns a *DeviceError if the device replies with an Error message.
 func (c *Client) receiveUntil(ctx context.Context, until MessageIdentifier) error {
 	for {
 		if err := c.Receive(ctx); err != nil {
 			return fmt.Errorf("receive until %v: %w", until, err)
 		}
+		if c.message.IsError() {
+			return fmt.Errorf("receive until %v: %w", until, &DeviceError{ErrorCode: c.message.ErrorCode()})
+		}
 		if c.MessageIdentifier() != until {
 			continue
 		}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"strings"
 	"testing"
@@ -61,6 +62,86 @@ func TestClient_GoToConfig(t *testing.T) {
 	assert.NilError(t, client.GoToConfig(ctx))
 }
 
+func TestClient_GoToConfig_ContextDone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedGoToConfig := []byte{0xfa, 0xff, 0x30, 0x0, 0xd1}
+	goToConfigAck := []byte{0xfa, 0xff, 0x31, 0x0, 0xd0}
+
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+
+	// the client should send a GoToConfig message on every attempt
+	port.EXPECT().Write(expectedGoToConfig).Times(2)
+
+	// and the device should be silent during the first attempt
+	silent := make(chan struct{})
+	port.EXPECT().
+		Read(gomock.Any()).
+		DoAndReturn(func(b []byte) (int, error) {
+			<-silent
+			copy(b, goToConfigAck)
+			return len(goToConfigAck), nil
+		})
+
+	// when the first attempt times out
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := client.GoToConfig(ctx)
+
+	// it should return the context's error
+	assert.Assert(t, errors.Is(err, context.DeadlineExceeded), err)
+
+	// and when the device answers during the second attempt
+	close(silent)
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel2()
+
+	// the second attempt should succeed
+	assert.NilError(t, client.GoToConfig(ctx2))
+}
+
+func TestClient_DeadlinePort_ContextDone(t *testing.T) {
+	clientConn, deviceConn := net.Pipe()
+	client := xsens.NewClient(clientConn)
+	defer func() {
+		assert.NilError(t, client.Close())
+	}()
+
+	// the device should receive a GoToConfig message, but not answer
+	received := make(chan []byte, 1)
+	go func() {
+		b := make([]byte, 5)
+		_, _ = io.ReadFull(deviceConn, b)
+		received <- b
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := client.GoToConfig(ctx)
+	assert.Assert(t, errors.Is(err, context.DeadlineExceeded), err)
+	assert.DeepEqual(t, []byte{0xfa, 0xff, 0x30, 0x0, 0xd1}, <-received)
+
+	// when the device sends the first half of a message before a receive times out
+	mtData2 := xsens.NewMessage(xsens.MessageIdentifierMTData2, []byte{0x10, 0x20, 0x02, 0x12, 0x34})
+	go func() {
+		_, _ = deviceConn.Write(mtData2[:4])
+	}()
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel2()
+	err = client.Receive(ctx2)
+	assert.Assert(t, errors.Is(err, context.DeadlineExceeded), err)
+
+	// and the second half of the message after the timeout
+	go func() {
+		_, _ = deviceConn.Write(mtData2[4:])
+	}()
+
+	// a receive without deadline should not be affected by the previous deadline and receive the whole message
+	assert.NilError(t, client.Receive(context.Background()))
+	assert.DeepEqual(t, []byte(mtData2), client.RawMessage())
+}
+
 func TestClient_GoToMeasurement(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -197,6 +278,550 @@ func TestClient_SetOutputConfiguration(t *testing.T) {
 	assert.NilError(t, client.SetOutputConfiguration(ctx, outputConfiguration))
 }
 
+func TestClient_SetOutputConfiguration_DeviceError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+
+	outputConfiguration := xsens.OutputConfiguration{
+		{
+			DataIdentifier: xsens.DataIdentifier{
+				DataType:  xsens.DataTypeLatLon,
+				Precision: xsens.PrecisionFloat32,
+			},
+			OutputFrequency: 3,
+		},
+	}
+	errorMessage := xsens.NewMessage(xsens.MessageIdentifierError, []byte{uint8(xsens.ErrorCodeInvalidPeriod)})
+
+	// the client should send a SetOutputConfiguration message
+	port.EXPECT().Write(gomock.Any())
+	// and when the device replies with an Error message
+	port.EXPECT().
+		Read(gomock.Any()).
+		DoAndReturn(func(b []byte) (int, error) {
+			copy(b, errorMessage)
+			return len(errorMessage), nil
+		})
+
+	deadline := time.Now().Add(100 * time.Millisecond)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	// it should return the device error
+	err := client.SetOutputConfiguration(ctx, outputConfiguration)
+	var deviceError *xsens.DeviceError
+	assert.Assert(t, errors.As(err, &deviceError), err)
+	assert.Equal(t, xsens.ErrorCodeInvalidPeriod, deviceError.ErrorCode)
+}
+
+// expectRequest expects the client to send a request, and replies with the provided reply.
+func expectRequest(port *mockserial.MockPort, request, reply xsens.Message) {
+	port.EXPECT().Write([]byte(request))
+	port.EXPECT().
+		Read(gomock.Any()).
+		DoAndReturn(func(b []byte) (int, error) {
+			copy(b, reply)
+			return len(reply), nil
+		})
+}
+
+func TestClient_GetAvailableFilterProfiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	var data []byte
+	data = append(data, 11, 1)
+	data = append(data, "general             "...)
+	data = append(data, 13, 2)
+	data = append(data, "VRU_general         "...)
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqAvailableFilterProfiles, nil),
+		xsens.NewMessage(xsens.MessageIdentifierAvailableFilterProfiles, data),
+	)
+	actual, err := client.GetAvailableFilterProfiles(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, xsens.FilterProfiles{
+		{Number: 11, Version: 1, Label: "general"},
+		{Number: 13, Version: 2, Label: "VRU_general"},
+	}, actual)
+}
+
+func TestClient_GetFilterProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqFilterProfile, nil),
+		xsens.NewMessage(xsens.MessageIdentifierReqFilterProfileAck, []byte{2, 13}),
+	)
+	actual, err := client.GetFilterProfile(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &xsens.FilterProfile{Number: 13, Version: 2}, actual)
+}
+
+func TestClient_SetFilterProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierSetFilterProfile, []byte{0, 13}),
+		xsens.NewMessage(xsens.MessageIdentifierSetFilterProfileAck, nil),
+	)
+	assert.NilError(t, client.SetFilterProfile(ctx, xsens.FilterProfile{Number: 13}))
+}
+
+func TestClient_GetAlignmentRotation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expected := xsens.Quaternion{Q0: 0.5, Q1: -0.5, Q2: 0.5, Q3: -0.5}
+	data, err := (&xsens.AlignmentRotation{Frame: xsens.AlignmentFrameLocal, Quaternion: expected}).MarshalBinary()
+	assert.NilError(t, err)
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqAlignmentRotation, []byte{1}),
+		xsens.NewMessage(xsens.MessageIdentifierReqAlignmentRotationAck, data),
+	)
+	actual, err := client.GetAlignmentRotation(ctx, xsens.AlignmentFrameLocal)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &expected, actual)
+}
+
+func TestClient_SetAlignmentRotation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(
+			xsens.MessageIdentifierSetAlignmentRotation,
+			[]byte{0, 0x3f, 0x80, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		),
+		xsens.NewMessage(xsens.MessageIdentifierSetAlignmentRotationAck, nil),
+	)
+	assert.NilError(t, client.SetAlignmentRotation(ctx, xsens.AlignmentFrameSensor, xsens.Quaternion{Q0: 1}))
+}
+
+func TestClient_GetGNSSLeverArm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqGpsLeverArm, nil),
+		xsens.NewMessage(
+			xsens.MessageIdentifierReqGpsLeverArmAck,
+			[]byte{0x3f, 0xa0, 0, 0, 0xbf, 0, 0, 0, 0x40, 0, 0, 0},
+		),
+	)
+	actual, err := client.GetGNSSLeverArm(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, xsens.VectorXYZ{X: 1.25, Y: -0.5, Z: 2}, *actual)
+}
+
+func TestClient_SetGNSSLeverArm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(
+			xsens.MessageIdentifierSetGpsLeverArm,
+			[]byte{0x3f, 0xa0, 0, 0, 0xbf, 0, 0, 0, 0x40, 0, 0, 0},
+		),
+		xsens.NewMessage(xsens.MessageIdentifierSetGpsLeverArmAck, nil),
+	)
+	assert.NilError(t, client.SetGNSSLeverArm(ctx, xsens.VectorXYZ{X: 1.25, Y: -0.5, Z: 2}))
+}
+
+func TestClient_GetLatLonAlt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expected := xsens.LatLonAlt{Lat: 57.7089, Lon: 11.9746, Alt: 52.25}
+	data, err := expected.MarshalBinary()
+	assert.NilError(t, err)
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqLatLonAlt, nil),
+		xsens.NewMessage(xsens.MessageIdentifierReqLatLonAltAck, data),
+	)
+	actual, err := client.GetLatLonAlt(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, expected, *actual)
+}
+
+func TestClient_SetLatLonAlt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	position := xsens.LatLonAlt{Lat: 57.7089, Lon: 11.9746, Alt: 52.25}
+	data, err := position.MarshalBinary()
+	assert.NilError(t, err)
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierSetLatLonAlt, data),
+		xsens.NewMessage(xsens.MessageIdentifierSetLatLonAltAck, nil),
+	)
+	assert.NilError(t, client.SetLatLonAlt(ctx, position))
+}
+
+func TestClient_GetBaudRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqBaudrate, nil),
+		xsens.NewMessage(xsens.MessageIdentifierReqBaudrateAck, []byte{0x80}),
+	)
+	actual, err := client.GetBaudRate(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, xsens.BaudRate(921600), actual)
+}
+
+func TestClient_SetBaudRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierSetBaudrate, []byte{0x80}),
+		xsens.NewMessage(xsens.MessageIdentifierSetBaudrateAck, nil),
+	)
+	assert.NilError(t, client.SetBaudRate(ctx, 921600))
+	assert.ErrorContains(t, client.SetBaudRate(ctx, 12345), "unsupported baud rate")
+}
+
+func TestClient_GetFirmwareRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqFirmwareRevision, nil),
+		xsens.NewMessage(
+			xsens.MessageIdentifierFirmwareRevision,
+			[]byte{1, 12, 3, 0, 0, 0, 42, 0, 1, 0x86, 0xa0},
+		),
+	)
+	actual, err := client.GetFirmwareRevision(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, xsens.FirmwareRevision{Major: 1, Minor: 12, Revision: 3, Build: 42, SVNRevision: 100000}, *actual)
+	assert.Equal(t, "1.12.3 build 42 rev 100000", actual.String())
+}
+
+func TestClient_RunSelfTest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierRunSelfTest, nil),
+		xsens.NewMessage(xsens.MessageIdentifierSelfTestResults, []byte{0x01, 0xf7}),
+	)
+	actual, err := client.RunSelfTest(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, actual.Passed(xsens.SelfTestAccX|xsens.SelfTestAccY|xsens.SelfTestAccZ))
+	assert.Assert(t, !actual.Passed(xsens.SelfTestGyrX))
+}
+
+func TestClient_GetDeviceInfo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqDID, nil),
+		xsens.NewMessage(xsens.MessageIdentifierDeviceID, []byte{0x03, 0x78, 0x12, 0x34}),
+	)
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqProductCode, nil),
+		xsens.NewMessage(xsens.MessageIdentifierProductCode, []byte("MTi-G-710-2A8G4 ")),
+	)
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqHWVersion, nil),
+		xsens.NewMessage(xsens.MessageIdentifierHWVersion, []byte{2, 1}),
+	)
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqFirmwareRevision, nil),
+		xsens.NewMessage(xsens.MessageIdentifierFirmwareRevision, []byte{1, 8, 2}),
+	)
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierRunSelfTest, nil),
+		xsens.NewMessage(xsens.MessageIdentifierSelfTestResults, []byte{0x7f, 0xff}),
+	)
+	actual, err := client.GetDeviceInfo(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &xsens.DeviceInfo{
+		DeviceID:         0x03781234,
+		ProductCode:      "MTi-G-710-2A8G4",
+		HWVersion:        "2.1",
+		FirmwareRevision: xsens.FirmwareRevision{Major: 1, Minor: 8, Revision: 2},
+		SelfTestResults:  0x7fff,
+	}, actual)
+}
+
+func TestClient_SetNoRotation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierSetNoRotation, []byte{0x01, 0x2c}),
+		xsens.NewMessage(xsens.MessageIdentifierSetNoRotationAck, nil),
+	)
+	assert.NilError(t, client.SetNoRotation(ctx, 5*time.Minute))
+	assert.ErrorContains(t, client.SetNoRotation(ctx, 24*time.Hour), "duration out of range")
+}
+
+func TestClient_ResetOrientation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierResetOrientation, []byte{0x00, 0x01}),
+		xsens.NewMessage(xsens.MessageIdentifierResetOrientationAck, nil),
+	)
+	assert.NilError(t, client.ResetOrientation(ctx, xsens.ResetOrientationCodeHeading))
+}
+
+func TestClient_StoreFilterState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierStoreFilterState, nil),
+		xsens.NewMessage(xsens.MessageIdentifierStoreFilterStateAck, nil),
+	)
+	assert.NilError(t, client.StoreFilterState(ctx))
+}
+
+func TestClient_GetSyncSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqSyncConfiguration, nil),
+		xsens.NewMessage(
+			xsens.MessageIdentifierSyncConfiguration,
+			[]byte{0x08, 0x01, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		),
+	)
+	actual, err := client.GetSyncSettings(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, xsens.SyncSettings{
+		{Function: xsens.SyncFunctionSendLatest, Line: xsens.SyncLineIn2, Polarity: xsens.SyncPolarityRisingEdge},
+	}, actual)
+}
+
+func TestClient_SetSyncSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(
+			xsens.MessageIdentifierSetSyncConfiguration,
+			[]byte{0x03, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00},
+		),
+		xsens.NewMessage(xsens.MessageIdentifierSetSyncConfigurationAck, nil),
+	)
+	assert.NilError(t, client.SetSyncSettings(ctx, xsens.SyncSettings{
+		{
+			Function:   xsens.SyncFunctionTriggerIndication,
+			Line:       xsens.SyncLineIn1,
+			Polarity:   xsens.SyncPolarityFallingEdge,
+			SkipFactor: 1,
+		},
+	}))
+}
+
+func TestClient_GetUTCTime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqUtcTime, nil),
+		xsens.NewMessage(
+			xsens.MessageIdentifierUtcTime,
+			[]byte{0x1d, 0xcd, 0x65, 0x00, 0x07, 0xea, 10, 17, 12, 30, 45, 0x07},
+		),
+	)
+	actual, err := client.GetUTCTime(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, time.Date(2026, time.October, 17, 12, 30, 45, 500000000, time.UTC), actual.Time())
+	assert.Assert(t, actual.Valid.IsTimeOfDayFullyResolved())
+}
+
+func TestClient_SetUTCTime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(
+			xsens.MessageIdentifierSetUtcTime,
+			[]byte{0x1d, 0xcd, 0x65, 0x00, 0x07, 0xea, 10, 17, 12, 30, 45, 0x03},
+		),
+		xsens.NewMessage(xsens.MessageIdentifierSetUtcTimeAck, nil),
+	)
+	ts := time.Date(2026, time.October, 17, 14, 30, 45, 500000000, time.FixedZone("CEST", 2*60*60))
+	assert.NilError(t, client.SetUTCTime(ctx, ts))
+}
+
+func TestClient_AdjustUTCTime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierAdjustUtcTime, []byte{0xff, 0xfe, 0x79, 0x60}),
+		xsens.NewMessage(xsens.MessageIdentifierAdjustUtcTimeAck, nil),
+	)
+	assert.NilError(t, client.AdjustUTCTime(ctx, -100*time.Microsecond))
+	assert.ErrorContains(t, client.AdjustUTCTime(ctx, 3*time.Second), "duration out of range")
+}
+
+func TestClient_GetActiveClockCorrection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierReqActiveClockCorrection, nil),
+		xsens.NewMessage(
+			xsens.MessageIdentifierActiveClockCorrection,
+			[]byte{0x3f, 0x00, 0x00, 0x00, 0xb7, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+		),
+	)
+	actual, err := client.GetActiveClockCorrection(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, xsens.ClockCorrection{Offset: 0.5, Drift: -1.0 / (1 << 16), Valid: true}, *actual)
+}
+
+func TestClient_StoreActiveClockCorrection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	expectRequest(
+		port,
+		xsens.NewMessage(xsens.MessageIdentifierStoreActiveClockCorrection, nil),
+		xsens.NewMessage(xsens.MessageIdentifierStoreActiveClockCorrectionAck, nil),
+	)
+	assert.NilError(t, client.StoreActiveClockCorrection(ctx))
+}
+
 func TestClient_Close(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -262,6 +887,141 @@ func TestClient_ScanMeasurementData(t *testing.T) {
 	}
 }
 
+func TestClient_ScanMeasurementData_UnknownPacket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+
+	packetCounter := xsens.PacketCounter(42)
+	packetCounterPacket, err := packetCounter.MarshalMTData2Packet(
+		xsens.DataIdentifier{DataType: xsens.DataTypePacketCounter},
+	)
+	assert.NilError(t, err)
+	unknownIdentifier := xsens.DataIdentifier{DataType: xsens.DataType(0xf010)}
+	unknownPacket := xsens.NewMTData2Package(3, unknownIdentifier)
+	copy(unknownPacket.Data(), []byte{1, 2, 3})
+	statusWord := xsens.StatusWord(7)
+	statusWordPacket, err := statusWord.MarshalMTData2Packet(xsens.DataIdentifier{DataType: xsens.DataTypeStatusWord})
+	assert.NilError(t, err)
+	var data []byte
+	data = append(data, packetCounterPacket...)
+	data = append(data, unknownPacket...)
+	data = append(data, statusWordPacket...)
+	// a GNSSSatInfo packet claiming a satellite, without satellite data
+	invalidPacket := xsens.NewMTData2Package(8, xsens.DataIdentifier{DataType: xsens.DataTypeGNSSSatInfo})
+	invalidPacket.Data()[4] = 1
+	var input bytes.Buffer
+	input.Write(xsens.NewMessage(xsens.MessageIdentifierMTData2, data))
+	input.Write(xsens.NewMessage(xsens.MessageIdentifierMTData2, invalidPacket))
+	port.EXPECT().Read(gomock.Any()).AnyTimes().DoAndReturn(input.Read)
+
+	deadline := time.Now().Add(100 * time.Millisecond)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	// packets following an unknown packet should be scanned
+	assert.NilError(t, client.Receive(ctx))
+	assert.Assert(t, client.ScanMeasurementData())
+	assert.Equal(t, xsens.DataTypePacketCounter, client.DataType())
+	assert.Assert(t, client.ScanMeasurementData())
+	assert.DeepEqual(
+		t,
+		&xsens.RawMeasurement{DataIdentifier: unknownIdentifier, Data: []byte{1, 2, 3}},
+		client.MeasurementData(),
+	)
+	assert.Assert(t, client.ScanMeasurementData())
+	assert.Equal(t, statusWord, *client.StatusWord())
+	assert.Assert(t, !client.ScanMeasurementData())
+	assert.NilError(t, client.Err())
+
+	// decode errors should be reported by Err
+	assert.NilError(t, client.Receive(ctx))
+	assert.Assert(t, !client.ScanMeasurementData())
+	assert.ErrorContains(t, client.Err(), "GNSSSatInfo")
+}
+
+func TestClient_ScanMeasurementData_CorruptPacket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	port := mockserial.NewMockPort(ctrl)
+	client := xsens.NewClient(port)
+
+	packetCounter := xsens.PacketCounter(42)
+	packetCounterPacket, err := packetCounter.MarshalMTData2Packet(
+		xsens.DataIdentifier{DataType: xsens.DataTypePacketCounter},
+	)
+	assert.NilError(t, err)
+	// a GNSSSatInfo packet claiming a satellite, without satellite data
+	corruptPacket := xsens.NewMTData2Package(8, xsens.DataIdentifier{DataType: xsens.DataTypeGNSSSatInfo})
+	corruptPacket.Data()[4] = 1
+	statusWord := xsens.StatusWord(7)
+	statusWordPacket, err := statusWord.MarshalMTData2Packet(xsens.DataIdentifier{DataType: xsens.DataTypeStatusWord})
+	assert.NilError(t, err)
+	var data []byte
+	data = append(data, packetCounterPacket...)
+	data = append(data, corruptPacket...)
+	data = append(data, statusWordPacket...)
+	input := bytes.NewReader(xsens.NewMessage(xsens.MessageIdentifierMTData2, data))
+	port.EXPECT().Read(gomock.Any()).AnyTimes().DoAndReturn(input.Read)
+
+	deadline := time.Now().Add(100 * time.Millisecond)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	// packets following a corrupt packet should be scanned
+	assert.NilError(t, client.Receive(ctx))
+	assert.Assert(t, client.ScanMeasurementData())
+	assert.Equal(t, packetCounter, *client.PacketCounter())
+	assert.Assert(t, client.ScanMeasurementData())
+	assert.Equal(t, statusWord, *client.StatusWord())
+	assert.Assert(t, !client.ScanMeasurementData())
+	// and the decode error should be reported by Err
+	assert.ErrorContains(t, client.Err(), "GNSSSatInfo")
+
+	// the snapshot should contain the packets that could be decoded
+	snapshot, err := client.Snapshot()
+	assert.ErrorContains(t, err, "GNSSSatInfo")
+	assert.DeepEqual(t, &xsens.Snapshot{PacketCounter: &packetCounter, StatusWord: &statusWord}, snapshot)
+}
+
+func TestClient_Snapshot(t *testing.T) {
+	f, err := os.Open("testdata/1/output.bin")
+	assert.NilError(t, err)
+	defer func() {
+		assert.NilError(t, f.Close())
+	}()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	port.EXPECT().Read(gomock.Any()).AnyTimes().DoAndReturn(f.Read)
+	client := xsens.NewClient(port)
+	deadline := time.Now().Add(100 * time.Millisecond)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	_, err = client.Snapshot()
+	assert.ErrorContains(t, err, "no current message")
+	assert.NilError(t, client.Receive(ctx))
+	snapshot, err := client.Snapshot()
+	assert.NilError(t, err)
+	var n int
+	for client.ScanMeasurementData() {
+		assert.Assert(t, snapshot.Has(client.DataType()), client.DataType())
+		n++
+	}
+	assert.Assert(t, n > 0)
+	assert.Equal(t, *client.PacketCounter(), *snapshot.PacketCounter)
+	expected := *snapshot.PacketCounter
+	// the snapshot should remain valid after receiving the next message
+	assert.NilError(t, client.Receive(ctx))
+	assert.NilError(t, client.Receive(ctx))
+	assert.Assert(t, client.ScanMeasurementData())
+	assert.Equal(t, expected, *snapshot.PacketCounter)
+}
+
 func TestUDPEmulator(t *testing.T) {
 	addrEmulator := "127.0.0.1:24001"
 	addrClient := "127.0.0.1:24002"
@@ -0,0 +1,59 @@
+package xsens
+
+import "strings"
+
+// ClipFlags represents the clip flags reported in a StatusWord, indicating out of range values on sensors.
+type ClipFlags uint16
+
+const (
+	ClipFlagAccX     ClipFlags = 1 << 0
+	ClipFlagAccY     ClipFlags = 1 << 1
+	ClipFlagAccZ     ClipFlags = 1 << 2
+	ClipFlagGyrX     ClipFlags = 1 << 3
+	ClipFlagGyrY     ClipFlags = 1 << 4
+	ClipFlagGyrZ     ClipFlags = 1 << 5
+	ClipFlagMagX     ClipFlags = 1 << 6
+	ClipFlagMagY     ClipFlags = 1 << 7
+	ClipFlagMagZ     ClipFlags = 1 << 8
+	ClipFlagClipping ClipFlags = 1 << 11
+)
+
+var clipFlagNames = []struct {
+	flag ClipFlags
+	name string
+}{
+	{flag: ClipFlagAccX, name: "AccX"},
+	{flag: ClipFlagAccY, name: "AccY"},
+	{flag: ClipFlagAccZ, name: "AccZ"},
+	{flag: ClipFlagGyrX, name: "GyrX"},
+	{flag: ClipFlagGyrY, name: "GyrY"},
+	{flag: ClipFlagGyrZ, name: "GyrZ"},
+	{flag: ClipFlagMagX, name: "MagX"},
+	{flag: ClipFlagMagY, name: "MagY"},
+	{flag: ClipFlagMagZ, name: "MagZ"},
+	{flag: ClipFlagClipping, name: "Clipping"},
+}
+
+// Has returns true if all of the provided flags are set.
+func (c ClipFlags) Has(flags ClipFlags) bool {
+	return c&flags == flags
+}
+
+// Names returns the names of the set flags.
+func (c ClipFlags) Names() []string {
+	names := make([]string, 0, len(clipFlagNames))
+	for _, f := range clipFlagNames {
+		if c.Has(f.flag) {
+			names = append(names, f.name)
+		}
+	}
+	return names
+}
+
+// String returns a string representation of the set flags.
+func (c ClipFlags) String() string {
+	if c == 0 {
+		return "None"
+	}
+	return strings.Join(c.Names(), "|")
+}
@@ -0,0 +1,42 @@
+package xsens
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// ClockCorrection is the active clock correction of an Xsens device, estimated from an external clock such as
+// GNSS or a SyncFunctionClockBiasEstimation sync line.
+type ClockCorrection struct {
+	// Offset is the estimated offset of the device clock from the external clock, in seconds.
+	Offset float64
+	// Drift is the estimated drift of the device clock relative to the external clock, in seconds per second.
+	Drift float64
+	// Valid is true when the clock correction has been estimated from an external clock.
+	Valid bool
+}
+
+const clockCorrectionLength = 12
+
+// MarshalBinary returns the wire representation of the clock correction.
+func (c *ClockCorrection) MarshalBinary() ([]byte, error) {
+	result := make([]byte, clockCorrectionLength)
+	binary.BigEndian.PutUint32(result[0:], math.Float32bits(float32(c.Offset)))
+	binary.BigEndian.PutUint32(result[4:], math.Float32bits(float32(c.Drift)))
+	if c.Valid {
+		binary.BigEndian.PutUint32(result[8:], 1)
+	}
+	return result, nil
+}
+
+// UnmarshalBinary sets *c from a wire representation of the clock correction.
+func (c *ClockCorrection) UnmarshalBinary(data []byte) error {
+	if n := len(data); n != clockCorrectionLength {
+		return fmt.Errorf("unexpected ClockCorrection length: want: %d, got: %d", clockCorrectionLength, n)
+	}
+	c.Offset = float64(math.Float32frombits(binary.BigEndian.Uint32(data[0:])))
+	c.Drift = float64(math.Float32frombits(binary.BigEndian.Uint32(data[4:])))
+	c.Valid = binary.BigEndian.Uint32(data[8:]) != 0
+	return nil
+}
@@ -0,0 +1,22 @@
+package xsens
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestClockCorrection_UnmarshalBinary(t *testing.T) {
+	data := []byte{
+		0x3f, 0x00, 0x00, 0x00, // offset: 0.5 s
+		0xb7, 0x80, 0x00, 0x00, // drift: -2^-16 s/s
+		0x00, 0x00, 0x00, 0x01, // validity: valid
+	}
+	var actual ClockCorrection
+	assert.NilError(t, actual.UnmarshalBinary(data))
+	assert.Equal(t, ClockCorrection{Offset: 0.5, Drift: -1.0 / (1 << 16), Valid: true}, actual)
+	marshaled, err := actual.MarshalBinary()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, data, marshaled)
+	assert.ErrorContains(t, actual.UnmarshalBinary(data[:8]), "unexpected ClockCorrection length")
+}
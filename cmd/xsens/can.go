@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"go.einride.tech/xsens"
+)
+
+func getCANConfigMain(ctx context.Context, client *xsens.Client, timeout time.Duration, useJSON bool) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := client.GoToConfig(ctx); err != nil {
+		return err
+	}
+	canConfiguration, err := client.GetCANConfiguration(ctx)
+	if err != nil {
+		return err
+	}
+	if useJSON {
+		js, err := json.Marshal(canConfiguration)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", js)
+	} else {
+		txt, err := canConfiguration.MarshalText()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("\n%s\n", txt)
+	}
+	return nil
+}
+
+func setCANConfigMain(ctx context.Context, client *xsens.Client, jsonFile string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	// parse CAN configuration
+	js, err := os.ReadFile(jsonFile)
+	if err != nil {
+		return err
+	}
+	var canConfiguration xsens.CANConfig
+	if err := json.Unmarshal(js, &canConfiguration); err != nil {
+		return err
+	}
+	// print CAN configuration
+	txt, err := canConfiguration.MarshalText()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Setting CAN configuration:\n\n%s\n", txt)
+	if err := client.GoToConfig(ctx); err != nil {
+		return err
+	}
+	return client.SetCANConfiguration(ctx, canConfiguration)
+}
+
+func getCANOutputConfigMain(ctx context.Context, client *xsens.Client, timeout time.Duration, useJSON bool) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := client.GoToConfig(ctx); err != nil {
+		return err
+	}
+	canOutputConfiguration, err := client.GetCANOutputConfiguration(ctx)
+	if err != nil {
+		return err
+	}
+	if useJSON {
+		js, err := json.Marshal(&canOutputConfiguration)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", js)
+	} else {
+		txt, err := canOutputConfiguration.MarshalText()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("\n%s\n", txt)
+	}
+	return nil
+}
+
+func setCANOutputConfigMain(ctx context.Context, client *xsens.Client, jsonFile string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	// parse CAN output configuration
+	js, err := os.ReadFile(jsonFile)
+	if err != nil {
+		return err
+	}
+	var canOutputConfiguration xsens.CANOutputConfiguration
+	if err := json.Unmarshal(js, &canOutputConfiguration); err != nil {
+		return err
+	}
+	// print CAN output configuration
+	txt, err := canOutputConfiguration.MarshalText()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Setting CAN output configuration:\n\n%s\n", txt)
+	if err := client.GoToConfig(ctx); err != nil {
+		return err
+	}
+	return client.SetCANOutputConfiguration(ctx, canOutputConfiguration)
+}
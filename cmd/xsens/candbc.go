@@ -0,0 +1,33 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"go.einride.tech/xsens"
+	"go.einride.tech/xsens/xsenscan"
+)
+
+// canDBCMain writes a DBC file for the CAN output configuration in the JSON file, to stdout if dbcFile is empty.
+func canDBCMain(jsonFile string, dbcFile string) error {
+	js, err := os.ReadFile(jsonFile)
+	if err != nil {
+		return err
+	}
+	var canOutputConfiguration xsens.CANOutputConfiguration
+	if err := json.Unmarshal(js, &canOutputConfiguration); err != nil {
+		return err
+	}
+	if dbcFile == "" {
+		return xsenscan.WriteDBC(os.Stdout, canOutputConfiguration)
+	}
+	f, err := os.Create(dbcFile)
+	if err != nil {
+		return err
+	}
+	if err := xsenscan.WriteDBC(f, canOutputConfiguration); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
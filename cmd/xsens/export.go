@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"go.einride.tech/xsens"
+	"go.einride.tech/xsens/xsensexport"
+	"go.einride.tech/xsens/xsensrecorder"
+)
+
+// messageSource returns the next message and its receive time, which is zero when unknown.
+type messageSource func(ctx context.Context) (time.Time, xsens.Message, error)
+
+func exportMain(
+	ctx context.Context,
+	source string,
+	format string,
+	configFile string,
+	baudRate int,
+	configTimeout time.Duration,
+) error {
+	var config xsens.OutputConfiguration
+	if configFile != "" {
+		js, err := os.ReadFile(configFile)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(js, &config); err != nil {
+			return err
+		}
+	}
+	next, closeSource, err := openMessageSource(ctx, source, baudRate, configTimeout, &config)
+	if err != nil {
+		return err
+	}
+	defer closeSource()
+	var w xsensexport.Writer
+	var exportErr error
+	for {
+		t, m, err := next(ctx)
+		if err != nil {
+			if errors.Is(err, xsens.ErrInvalidMessage) {
+				fmt.Fprintf(os.Stderr, "export: %v\n", err)
+				continue
+			}
+			if !errors.Is(err, io.EOF) && !errors.Is(err, context.Canceled) {
+				exportErr = err
+			}
+			break
+		}
+		if err := m.Validate(); err != nil {
+			// recordings are not validated when read
+			fmt.Fprintf(os.Stderr, "export: %v: %v\n", m, err)
+			continue
+		}
+		if m.Identifier() != xsens.MessageIdentifierMTData2 {
+			continue
+		}
+		var snapshot xsens.Snapshot
+		if err := snapshot.UnmarshalMTData2(m.Data()); err != nil {
+			// export the packets that could be decoded, leaving the values of the others empty
+			fmt.Fprintf(os.Stderr, "export: %v: %v\n", m, err)
+		}
+		if w == nil {
+			if config == nil {
+				// derive the output configuration from the first message
+				config = outputConfigurationOf(m.Data())
+			}
+			if w, err = newExportWriter(format, config); err != nil {
+				return err
+			}
+		}
+		if err := w.Write(t, &snapshot); err != nil {
+			exportErr = err
+			break
+		}
+	}
+	// flush the exported rows, also when interrupted or failing
+	if w != nil {
+		if err := w.Flush(); err != nil && exportErr == nil {
+			exportErr = err
+		}
+	}
+	return exportErr
+}
+
+func newExportWriter(format string, config xsens.OutputConfiguration) (xsensexport.Writer, error) {
+	switch format {
+	case "csv":
+		return xsensexport.NewCSVWriter(os.Stdout, config), nil
+	case "jsonl":
+		return xsensexport.NewJSONLinesWriter(os.Stdout), nil
+	default:
+		return nil, fmt.Errorf("invalid export format: %s", format)
+	}
+}
+
+// openMessageSource opens a recording, a raw message stream file or a serial port.
+//
+// The output configuration of a serial port is read from the device, unless already provided.
+func openMessageSource(
+	ctx context.Context,
+	source string,
+	baudRate int,
+	configTimeout time.Duration,
+	config *xsens.OutputConfiguration,
+) (messageSource, func(), error) {
+	if info, err := os.Stat(source); err == nil && info.Mode().IsRegular() {
+		return openFileMessageSource(source)
+	}
+	port, err := openSerialPort(ctx, source, baudRate)
+	if err != nil {
+		return nil, nil, err
+	}
+	client := xsens.NewClient(port)
+	// close the port when the context is done, to abort pending reads
+	stop := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-stop:
+		}
+		_ = client.Close()
+	}()
+	closeSource := func() {
+		close(stop)
+	}
+	if *config == nil {
+		configCtx, cancel := context.WithTimeout(ctx, configTimeout)
+		defer cancel()
+		if err := client.GoToConfig(configCtx); err != nil {
+			closeSource()
+			return nil, nil, err
+		}
+		if *config, err = client.GetOutputConfiguration(configCtx); err != nil {
+			closeSource()
+			return nil, nil, err
+		}
+	}
+	if err := client.GoToMeasurement(ctx); err != nil {
+		closeSource()
+		return nil, nil, err
+	}
+	// the message received when going to measurement is the first message
+	first := true
+	next := func(ctx context.Context) (time.Time, xsens.Message, error) {
+		if !first {
+			if err := client.Receive(ctx); err != nil {
+				return time.Time{}, nil, err
+			}
+		}
+		first = false
+		return time.Now(), client.RawMessage(), nil
+	}
+	return next, closeSource, nil
+}
+
+// openFileMessageSource opens a recording, or a file containing a raw message stream.
+func openFileMessageSource(file string) (messageSource, func(), error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	closeSource := func() {
+		_ = f.Close()
+	}
+	if r, err := xsensrecorder.NewReader(f); err == nil {
+		next := func(context.Context) (time.Time, xsens.Message, error) {
+			if !r.Scan() {
+				if err := r.Err(); err != nil {
+					return time.Time{}, nil, err
+				}
+				return time.Time{}, nil, io.EOF
+			}
+			return r.Time(), r.Message(), nil
+		}
+		return next, closeSource, nil
+	}
+	// not a recording, read the file as a raw message stream
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		closeSource()
+		return nil, nil, err
+	}
+	client := xsens.NewClient(f)
+	next := func(ctx context.Context) (time.Time, xsens.Message, error) {
+		if err := client.Receive(ctx); err != nil {
+			return time.Time{}, nil, err
+		}
+		return time.Time{}, client.RawMessage(), nil
+	}
+	return next, closeSource, nil
+}
+
+// outputConfigurationOf returns an output configuration with the data identifiers of the packets in m.
+func outputConfigurationOf(m xsens.MTData2) xsens.OutputConfiguration {
+	var config xsens.OutputConfiguration
+	for i := 0; i < len(m); {
+		packet, err := m.PacketAt(i)
+		if err != nil {
+			break
+		}
+		i += len(packet)
+		config = append(config, xsens.OutputConfigurationSetting{DataIdentifier: packet.Identifier()})
+	}
+	return config
+}
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"strings"
@@ -12,6 +15,7 @@ import (
 
 	"go.bug.st/serial"
 	"go.einride.tech/xsens"
+	"go.einride.tech/xsens/xsensrecorder"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -21,15 +25,28 @@ func main() {
 	ctx := withCancelOnSignal(context.Background(), os.Interrupt)
 	flags := flag.NewFlagSet("xsens", flag.ExitOnError)
 	jsonFlag := flags.Bool("json", false, "use JSON output")
-	baudRateFlag := flags.Int("baudRate", DefaultBaudRate, "baud rate for serial communication")
+	baudRateFlag := flags.Int("baudRate", DefaultBaudRate, "baud rate for serial communication, 0 detects the baud rate")
 	configTimeoutFlag := flags.Duration("configTimeout", time.Second, "timeout for config operations")
+	speedFlag := flags.Float64("speed", 1, "replay speed factor, 0 replays as fast as possible")
+	toFlag := flags.String("to", "pty", "replay destination, udp://<host>:<port> or pty")
+	formatFlag := flags.String("format", "csv", "export format, csv or jsonl")
+	configFlag := flags.String("config", "", "output configuration JSON file, defining the exported columns")
 	usage := func() {
 		fmt.Print(`
 usage:
 
-	xsens read [-baudRate <int>] <port>
+	xsens read [-baudRate <int>] [-json] <port>
 	xsens get-output-config [-baudRate <int>] [-json] [-configTimeout <duration>] <port>
-	xsens set-ouptut-config [-baudRate <int>] [-configTimeout <duration>] <port> <config.json>
+	xsens set-output-config [-baudRate <int>] [-configTimeout <duration>] <port> <config.json>
+	xsens get-can-config [-baudRate <int>] [-json] [-configTimeout <duration>] <port>
+	xsens set-can-config [-baudRate <int>] [-configTimeout <duration>] <port> <can-config.json>
+	xsens get-can-output-config [-baudRate <int>] [-json] [-configTimeout <duration>] <port>
+	xsens set-can-output-config [-baudRate <int>] [-configTimeout <duration>] <port> <can-output-config.json>
+	xsens info [-baudRate <int>] [-json] [-configTimeout <duration>] <port>
+	xsens record [-baudRate <int>] <port> <file>
+	xsens replay [-speed <float>] [-to udp://<host>:<port>|pty] <file>
+	xsens export [-baudRate <int>] [-format csv|jsonl] [-config <config.json>] [-configTimeout <duration>] <port|file>
+	xsens can-dbc <can-output-config.json> [<file.dbc>]
 
 `)
 		flags.PrintDefaults()
@@ -48,13 +65,54 @@ usage:
 		return flags.Arg(i)
 	}
 	_ = flags.Parse(args)
+	if subcommand == "replay" {
+		if err := replayMain(ctx, arg(0), *speedFlag, *toFlag); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+	if subcommand == "export" {
+		err := exportMain(ctx, arg(0), *formatFlag, *configFlag, *baudRateFlag, *configTimeoutFlag)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+	if subcommand == "can-dbc" {
+		if err := canDBCMain(arg(0), flags.Arg(1)); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
 	portName := arg(0)
-	port, err := serial.Open(portName, &serial.Mode{BaudRate: *baudRateFlag})
+	port, err := openSerialPort(ctx, portName, *baudRateFlag)
 	if err != nil {
 		fmt.Println(err)
 		usage()
 	}
-	client := xsens.NewClient(port)
+	var clientPort io.ReadWriteCloser = port
+	var recording *bufio.Writer
+	if subcommand == "record" {
+		f, err := os.Create(arg(1))
+		if err != nil {
+			fmt.Println(err)
+			usage()
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		recording = bufio.NewWriter(f)
+		w, err := xsensrecorder.NewWriter(recording, time.Now())
+		if err != nil {
+			fmt.Println(err)
+			usage()
+		}
+		clientPort = xsensrecorder.NewRecordingPort(port, w)
+	}
+	client := xsens.NewClient(clientPort)
 	g, ctx := errgroup.WithContext(ctx)
 	ctx, cancel := context.WithCancel(ctx)
 	g.Go(func() error {
@@ -65,7 +123,7 @@ usage:
 	case "read":
 		g.Go(func() error {
 			defer cancel()
-			return readMain(ctx, client)
+			return readMain(ctx, client, *jsonFlag)
 		})
 	case "get-output-config":
 		g.Go(func() error {
@@ -77,19 +135,58 @@ usage:
 			defer cancel()
 			return setOutputConfigMain(ctx, client, arg(1), *configTimeoutFlag)
 		})
+	case "get-can-config":
+		g.Go(func() error {
+			defer cancel()
+			return getCANConfigMain(ctx, client, *configTimeoutFlag, *jsonFlag)
+		})
+	case "set-can-config":
+		g.Go(func() error {
+			defer cancel()
+			return setCANConfigMain(ctx, client, arg(1), *configTimeoutFlag)
+		})
+	case "get-can-output-config":
+		g.Go(func() error {
+			defer cancel()
+			return getCANOutputConfigMain(ctx, client, *configTimeoutFlag, *jsonFlag)
+		})
+	case "set-can-output-config":
+		g.Go(func() error {
+			defer cancel()
+			return setCANOutputConfigMain(ctx, client, arg(1), *configTimeoutFlag)
+		})
+	case "info":
+		g.Go(func() error {
+			defer cancel()
+			return infoMain(ctx, client, *configTimeoutFlag, *jsonFlag)
+		})
+	case "record":
+		g.Go(func() error {
+			defer cancel()
+			return recordMain(ctx, client)
+		})
 	default:
 		usage()
 	}
-	if err := g.Wait(); err != nil && !strings.Contains(err.Error(), "closed") {
+	err = g.Wait()
+	if recording != nil {
+		if err := recording.Flush(); err != nil {
+			fmt.Println(err)
+		}
+	}
+	if err != nil && !errors.Is(err, context.Canceled) {
 		fmt.Println(err)
 		usage()
 	}
 }
 
-func readMain(ctx context.Context, client *xsens.Client) error {
+func readMain(ctx context.Context, client *xsens.Client, useJSON bool) error {
 	if err := client.GoToMeasurement(ctx); err != nil {
 		return err
 	}
+	if useJSON {
+		return readJSONMain(ctx, client)
+	}
 	for {
 		fmt.Println()
 		fmt.Println(client.MessageIdentifier())
@@ -97,8 +194,58 @@ func readMain(ctx context.Context, client *xsens.Client) error {
 			fmt.Printf("\t%v\n", client.DataType())
 			fmt.Printf("\t%+v\n", client.MeasurementData())
 		}
+		if err := client.Err(); err != nil {
+			fmt.Printf("\t%v\n", err)
+		}
 		if err := client.Receive(ctx); err != nil {
-			if strings.Contains(err.Error(), "closed") {
+			if errors.Is(err, context.Canceled) {
+				// interrupted
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+func recordMain(ctx context.Context, client *xsens.Client) error {
+	var n int
+	defer func() {
+		fmt.Printf("recorded %d messages\n", n)
+	}()
+	// the messages received when going to measurement mode are recorded as well
+	if err := client.GoToMeasurement(ctx); err != nil {
+		return err
+	}
+	n++
+	for {
+		if err := client.Receive(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				// interrupted, which is the only way to stop recording
+				return nil
+			}
+			return err
+		}
+		n++
+	}
+}
+
+// readJSONMain prints a snapshot of the current message and every subsequent MTData2 message as JSON.
+func readJSONMain(ctx context.Context, client *xsens.Client) error {
+	enc := json.NewEncoder(os.Stdout)
+	for {
+		if client.MessageIdentifier() == xsens.MessageIdentifierMTData2 {
+			snapshot, err := client.Snapshot()
+			if err != nil {
+				// the snapshot contains the packets that could be decoded
+				fmt.Fprintln(os.Stderr, err)
+			}
+			if err := enc.Encode(snapshot); err != nil {
+				return err
+			}
+		}
+		if err := client.Receive(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				// interrupted
 				return nil
 			}
 			return err
@@ -132,6 +279,34 @@ func getOutputConfigMain(ctx context.Context, client *xsens.Client, timeout time
 	return nil
 }
 
+func infoMain(ctx context.Context, client *xsens.Client, timeout time.Duration, useJSON bool) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := client.GoToConfig(ctx); err != nil {
+		return err
+	}
+	info, err := client.GetDeviceInfo(ctx)
+	if err != nil {
+		return err
+	}
+	if useJSON {
+		js, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", js)
+		return nil
+	}
+	fmt.Println()
+	fmt.Printf("Device ID:         %s\n", info.DeviceID.HexString())
+	fmt.Printf("Product code:      %s\n", info.ProductCode)
+	fmt.Printf("Hardware version:  %s\n", info.HWVersion)
+	fmt.Printf("Firmware revision: %s\n", &info.FirmwareRevision)
+	fmt.Printf("Self test passed:  %s\n", strings.Join(info.SelfTestResults.PassedNames(), ", "))
+	fmt.Printf("Self test failed:  %s\n", strings.Join(info.SelfTestResults.FailedNames(), ", "))
+	return nil
+}
+
 func setOutputConfigMain(ctx context.Context, client *xsens.Client, jsonFile string, timeout time.Duration) error {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
@@ -153,6 +328,14 @@ func setOutputConfigMain(ctx context.Context, client *xsens.Client, jsonFile str
 	if err := client.GoToConfig(ctx); err != nil {
 		return err
 	}
+	// validate output configuration against the device
+	productCode, err := client.GetProductCode(ctx)
+	if err != nil {
+		return err
+	}
+	if err := outputConfiguration.Validate(xsens.WithProductFamily(productCode.ProductFamily())); err != nil {
+		return err
+	}
 	return client.SetOutputConfiguration(ctx, outputConfiguration)
 }
 
@@ -167,3 +350,23 @@ func withCancelOnSignal(ctx context.Context, sig ...os.Signal) context.Context {
 	}()
 	return ctx
 }
+
+// openSerialPort opens a serial port at the baud rate, or at the detected baud rate of the device when zero.
+//
+// Detecting the baud rate leaves the device in config mode.
+func openSerialPort(ctx context.Context, portName string, baudRate int) (serial.Port, error) {
+	if baudRate != 0 {
+		return serial.Open(portName, &serial.Mode{BaudRate: baudRate})
+	}
+	port, err := serial.Open(portName, &serial.Mode{BaudRate: xsens.DefaultSerialBaudRate})
+	if err != nil {
+		return nil, err
+	}
+	detected, err := xsens.DetectBaudRate(ctx, port)
+	if err != nil {
+		_ = port.Close()
+		return nil, err
+	}
+	fmt.Fprintf(os.Stderr, "detected baud rate: %d\n", detected)
+	return port, nil
+}
@@ -0,0 +1,52 @@
+//go:build linux
+// +build linux
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// openPTY opens a pseudo-terminal in raw mode, returning its master side and the path to its slave side.
+func openPTY() (io.ReadWriteCloser, string, error) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		return nil, "", fmt.Errorf("open pty: %w", err)
+	}
+	name, err := initPTY(int(master.Fd()))
+	if err != nil {
+		_ = master.Close()
+		return nil, "", fmt.Errorf("open pty: %w", err)
+	}
+	return master, name, nil
+}
+
+// initPTY unlocks the pseudo-terminal and sets it to raw mode, returning the path to its slave side.
+func initPTY(fd int) (string, error) {
+	if err := unix.IoctlSetPointerInt(fd, unix.TIOCSPTLCK, 0); err != nil {
+		return "", fmt.Errorf("unlock: %w", err)
+	}
+	n, err := unix.IoctlGetUint32(fd, unix.TIOCGPTN)
+	if err != nil {
+		return "", fmt.Errorf("get number: %w", err)
+	}
+	termios, err := unix.IoctlGetTermios(fd, unix.TCGETS)
+	if err != nil {
+		return "", fmt.Errorf("get attributes: %w", err)
+	}
+	// raw mode, as by cfmakeraw(3)
+	termios.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL |
+		unix.IXON
+	termios.Oflag &^= unix.OPOST
+	termios.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	termios.Cflag &^= unix.CSIZE | unix.PARENB
+	termios.Cflag |= unix.CS8
+	if err := unix.IoctlSetTermios(fd, unix.TCSETS, termios); err != nil {
+		return "", fmt.Errorf("set attributes: %w", err)
+	}
+	return fmt.Sprintf("/dev/pts/%d", n), nil
+}
@@ -0,0 +1,14 @@
+//go:build !linux
+// +build !linux
+
+package main
+
+import (
+	"errors"
+	"io"
+)
+
+// openPTY opens a pseudo-terminal, which is only supported on Linux.
+func openPTY() (io.ReadWriteCloser, string, error) {
+	return nil, "", errors.New("open pty: only supported on Linux")
+}
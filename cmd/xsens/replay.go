@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"go.einride.tech/xsens/xsensemulator"
+	"go.einride.tech/xsens/xsensrecorder"
+	"golang.org/x/sync/errgroup"
+)
+
+func replayMain(ctx context.Context, file string, speed float64, to string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	r, err := xsensrecorder.NewReader(f)
+	if err != nil {
+		return err
+	}
+	destination, err := openReplayDestination(to)
+	if err != nil {
+		return err
+	}
+	replayer := xsensrecorder.NewReplayer(r, xsensrecorder.WithSpeed(speed))
+	g, ctx := errgroup.WithContext(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	g.Go(func() error {
+		<-ctx.Done()
+		_ = replayer.Close()
+		return destination.Close()
+	})
+	g.Go(func() error {
+		// discard data written to the destination, such as requests from a client
+		_, _ = io.Copy(io.Discard, destination)
+		return nil
+	})
+	g.Go(func() error {
+		defer cancel()
+		// the replayer returns at most one message per read, which is written as one datagram over UDP
+		if _, err := io.Copy(destination, replayer); err != nil && !errors.Is(err, os.ErrClosed) {
+			return err
+		}
+		return nil
+	})
+	return g.Wait()
+}
+
+func openReplayDestination(to string) (io.ReadWriteCloser, error) {
+	switch {
+	case strings.HasPrefix(to, "udp://"):
+		addr := strings.TrimPrefix(to, "udp://")
+		port, err := xsensemulator.NewUDPSerialPort(":0", addr)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("replaying to %s\n", to)
+		return port, nil
+	case to == "pty":
+		pty, name, err := openPTY()
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("replaying to %s\n", name)
+		return pty, nil
+	default:
+		return nil, fmt.Errorf("invalid replay destination: %s", to)
+	}
+}
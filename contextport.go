@@ -0,0 +1,205 @@
+package xsens
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// deadlinePort is implemented by ports that support I/O deadlines, such as net.Conn and *os.File.
+type deadlinePort interface {
+	SetReadDeadline(time.Time) error
+	SetWriteDeadline(time.Time) error
+}
+
+// aLongTimeAgo is a deadline in the past, used for aborting pending I/O on ports that support deadlines.
+var aLongTimeAgo = time.Unix(1, 0)
+
+// contextPort performs I/O on a port, aborting pending reads and writes when their current context is done.
+//
+// Reads and writes have separate contexts, and may be performed concurrently.
+//
+// Ports that support deadlines have pending I/O aborted by setting a deadline, which is cleared when the I/O
+// completes. For other ports, I/O is performed in a goroutine and the result of an aborted operation is kept for
+// the next operation, so that no data is lost.
+type contextPort struct {
+	p        io.ReadWriteCloser
+	readCtx  context.Context
+	writeCtx context.Context
+	// read state
+	readBuf     []byte
+	readData    []byte
+	readErr     error
+	pendingRead chan ioResult
+	// write state
+	pendingWrite chan ioResult
+}
+
+// ioResult is the result of an I/O operation performed in a goroutine.
+type ioResult struct {
+	n   int
+	err error
+}
+
+// orBackground returns ctx, or the background context if ctx is nil.
+func orBackground(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+	return ctx
+}
+
+// Read from the port, returning the read context's error if it is done before the read completes.
+func (c *contextPort) Read(b []byte) (int, error) {
+	if len(c.readData) > 0 {
+		n := copy(b, c.readData)
+		c.readData = c.readData[n:]
+		return n, nil
+	}
+	if c.readErr != nil {
+		err := c.readErr
+		c.readErr = nil
+		return 0, err
+	}
+	ctx := orBackground(c.readCtx)
+	if c.pendingRead == nil {
+		if ctx.Done() == nil {
+			return c.p.Read(b)
+		}
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if d, ok := c.p.(deadlinePort); ok {
+			if n, ok, err := c.readWithDeadline(ctx, d, b); ok {
+				return n, err
+			}
+		}
+		if cap(c.readBuf) < len(b) {
+			c.readBuf = make([]byte, len(b))
+		}
+		buf := c.readBuf[:len(b)]
+		c.pendingRead = make(chan ioResult, 1)
+		go func(result chan<- ioResult) {
+			n, err := c.p.Read(buf)
+			result <- ioResult{n: n, err: err}
+		}(c.pendingRead)
+	}
+	select {
+	case result := <-c.pendingRead:
+		c.pendingRead = nil
+		n := copy(b, c.readBuf[:result.n])
+		if n < result.n {
+			// keep the remaining data and error for subsequent reads
+			c.readData = c.readBuf[n:result.n]
+			c.readErr = result.err
+			return n, nil
+		}
+		return n, result.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// readWithDeadline reads from a port that supports deadlines.
+//
+// Returns false if the port failed to set a deadline, in which case no read has been performed.
+func (c *contextPort) readWithDeadline(ctx context.Context, d deadlinePort, b []byte) (int, bool, error) {
+	deadline, _ := ctx.Deadline()
+	if err := d.SetReadDeadline(deadline); err != nil {
+		return 0, false, nil
+	}
+	stop := afterDone(ctx, func() {
+		_ = d.SetReadDeadline(aLongTimeAgo)
+	})
+	n, err := c.p.Read(b)
+	stop()
+	// clear the deadline, to not affect subsequent reads without a deadline
+	_ = d.SetReadDeadline(time.Time{})
+	if err != nil && ctx.Err() != nil {
+		err = ctx.Err()
+	}
+	return n, true, err
+}
+
+// Write to the port, returning the write context's error if it is done before the write completes.
+func (c *contextPort) Write(b []byte) (int, error) {
+	ctx := orBackground(c.writeCtx)
+	if c.pendingWrite != nil {
+		// wait for a previously aborted write to complete, to avoid interleaving data
+		select {
+		case result := <-c.pendingWrite:
+			c.pendingWrite = nil
+			if result.err != nil {
+				return 0, result.err
+			}
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+	if ctx.Done() == nil {
+		return c.p.Write(b)
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	if d, ok := c.p.(deadlinePort); ok {
+		if n, ok, err := c.writeWithDeadline(ctx, d, b); ok {
+			return n, err
+		}
+	}
+	// copy the data, since the write may outlive the call
+	buf := append([]byte(nil), b...)
+	c.pendingWrite = make(chan ioResult, 1)
+	go func(result chan<- ioResult) {
+		n, err := c.p.Write(buf)
+		result <- ioResult{n: n, err: err}
+	}(c.pendingWrite)
+	select {
+	case result := <-c.pendingWrite:
+		c.pendingWrite = nil
+		return result.n, result.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// writeWithDeadline writes to a port that supports deadlines.
+//
+// Returns false if the port failed to set a deadline, in which case no write has been performed.
+func (c *contextPort) writeWithDeadline(ctx context.Context, d deadlinePort, b []byte) (int, bool, error) {
+	deadline, _ := ctx.Deadline()
+	if err := d.SetWriteDeadline(deadline); err != nil {
+		return 0, false, nil
+	}
+	stop := afterDone(ctx, func() {
+		_ = d.SetWriteDeadline(aLongTimeAgo)
+	})
+	n, err := c.p.Write(b)
+	stop()
+	// clear the deadline, to not affect subsequent writes without a deadline
+	_ = d.SetWriteDeadline(time.Time{})
+	if err != nil && ctx.Err() != nil {
+		err = ctx.Err()
+	}
+	return n, true, err
+}
+
+// afterDone calls f in a goroutine when ctx is done.
+//
+// The returned stop function prevents f from being called and waits for an ongoing call to return.
+func afterDone(ctx context.Context, f func()) (stop func()) {
+	stopChan := make(chan struct{})
+	doneChan := make(chan struct{})
+	go func() {
+		defer close(doneChan)
+		select {
+		case <-ctx.Done():
+			f()
+		case <-stopChan:
+		}
+	}()
+	return func() {
+		close(stopChan)
+		<-doneChan
+	}
+}
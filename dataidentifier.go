@@ -77,15 +77,27 @@ func (d DataIdentifier) DataSize() uint8 {
 	case DataTypeBaroPressure:
 		return 4
 	case DataTypeLatLon:
-		return 2
+		return 2 * d.Precision.Size()
 	case DataTypeGNSSPVTData:
-		return 76
+		return 94
 	case DataTypeGNSSSatInfo:
 		return 8 // plus variable number of satellites
 	case DataTypeStatusByte:
 		return 1
 	case DataTypeStatusWord:
 		return 4
+	case DataTypeITOW:
+		return 4
+	case DataTypeGPSAge, DataTypePressureAge:
+		return 1
+	case DataTypeGPSDOP:
+		return 18
+	case DataTypeGPSSOL:
+		return 52
+	case DataTypeGPSTimeUTC:
+		return 20
+	case DataTypeGPSSVInfo:
+		return 8 // plus variable number of channels
 	}
 	return 0
 }
@@ -61,6 +61,29 @@ func TestDataIdentifier_DataSize(t *testing.T) {
 			},
 			dataSize: 16,
 		},
+		{
+			dataIdentifier: DataIdentifier{
+				DataType:  DataTypeLatLon,
+				Precision: PrecisionFP1632,
+			},
+			dataSize: 12,
+		},
+		{
+			dataIdentifier: DataIdentifier{DataType: DataTypeGNSSPVTData},
+			dataSize:       94,
+		},
+		{
+			dataIdentifier: DataIdentifier{DataType: DataTypeGPSDOP},
+			dataSize:       18,
+		},
+		{
+			dataIdentifier: DataIdentifier{DataType: DataTypeGPSSOL},
+			dataSize:       52,
+		},
+		{
+			dataIdentifier: DataIdentifier{DataType: DataTypeGPSTimeUTC},
+			dataSize:       20,
+		},
 	} {
 		tt := tt
 		t.Run(tt.dataIdentifier.String(), func(t *testing.T) {
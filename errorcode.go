@@ -1,5 +1,7 @@
 package xsens
 
+import "fmt"
+
 // ErrorCode represents an Xsens error code.
 type ErrorCode uint8
 
@@ -78,3 +80,14 @@ const (
 	// ErrorCodeBufferOverflow: Sample buffer of the device was full during a communication outage.
 	ErrorCodeBufferOverflow ErrorCode = 42
 )
+
+// DeviceError is an error reported by an Xsens device in an Error message.
+type DeviceError struct {
+	// ErrorCode is the error code reported by the device.
+	ErrorCode ErrorCode
+}
+
+// Error implements the error interface.
+func (e *DeviceError) Error() string {
+	return fmt.Sprintf("device error: %v", e.ErrorCode)
+}
@@ -40,6 +40,9 @@ func main() {
 			log.Printf("\t%v", client.DataType())
 			log.Printf("\t%+v", client.MeasurementData())
 		}
+		if err := client.Err(); err != nil {
+			log.Printf("\t%v", err)
+		}
 		// Receive next MTData2 message.
 		if err := client.Receive(ctx); err != nil {
 			log.Panic(err)
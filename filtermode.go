@@ -0,0 +1,22 @@
+package xsens
+
+// FilterMode represents the filter mode reported in a StatusWord.
+type FilterMode uint8
+
+//go:generate stringer -type FilterMode -trimprefix FilterMode
+
+const (
+	// FilterModeWithoutGNSS is used when the filter profile is in VRU mode.
+	FilterModeWithoutGNSS FilterMode = 0x0
+
+	// FilterModeCoasting is used when GNSS has been lost less than 60 seconds ago.
+	FilterModeCoasting FilterMode = 0x1
+
+	// FilterModeWithGNSS is used when GNSS is available.
+	FilterModeWithGNSS FilterMode = 0x3
+)
+
+// MarshalText implements encoding.TextMarshaler.
+func (f FilterMode) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
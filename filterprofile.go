@@ -0,0 +1,87 @@
+package xsens
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FilterProfile is a filter profile of an Xsens device, such as "general" or "VRU_general".
+type FilterProfile struct {
+	// Number is the filter profile type number.
+	Number uint8
+	// Version is the filter profile version.
+	Version uint8
+	// Label is the filter profile label, only provided by the available filter profiles.
+	Label string
+}
+
+const (
+	filterProfileLength      = 22
+	filterProfileLabelLength = 20
+)
+
+// String returns a string representation of the filter profile.
+func (f *FilterProfile) String() string {
+	return fmt.Sprintf("%s (number %d, version %d)", f.Label, f.Number, f.Version)
+}
+
+// MarshalBinary returns the wire representation of the filter profile, as listed by the available filter profiles.
+func (f *FilterProfile) MarshalBinary() ([]byte, error) {
+	if len(f.Label) > filterProfileLabelLength {
+		return nil, fmt.Errorf("filter profile label too long: %d bytes", len(f.Label))
+	}
+	result := make([]byte, filterProfileLength)
+	result[0] = f.Number
+	result[1] = f.Version
+	copy(result[2:], f.Label)
+	for i := 2 + len(f.Label); i < filterProfileLength; i++ {
+		result[i] = ' '
+	}
+	return result, nil
+}
+
+// UnmarshalBinary sets *f from a wire representation of the filter profile, as listed by the available filter
+// profiles.
+func (f *FilterProfile) UnmarshalBinary(data []byte) error {
+	if l := len(data); l != filterProfileLength {
+		return fmt.Errorf("unexpected FilterProfile length: want: %d, got: %d", filterProfileLength, l)
+	}
+	f.Number = data[0]
+	f.Version = data[1]
+	f.Label = strings.TrimRight(string(data[2:]), " \x00")
+	return nil
+}
+
+// marshalFilterProfileID returns the wire representation of the filter profile's version and number.
+func (f *FilterProfile) marshalFilterProfileID() []byte {
+	return []byte{f.Version, f.Number}
+}
+
+// unmarshalFilterProfileID sets the filter profile's version and number from their wire representation.
+func (f *FilterProfile) unmarshalFilterProfileID(data []byte) error {
+	if l := len(data); l != 2 {
+		return fmt.Errorf("unexpected FilterProfile length: want: %d, got: %d", 2, l)
+	}
+	f.Version = data[0]
+	f.Number = data[1]
+	return nil
+}
+
+// FilterProfiles is a list of filter profiles.
+type FilterProfiles []FilterProfile
+
+// UnmarshalBinary sets *f from a wire representation of the available filter profiles.
+func (f *FilterProfiles) UnmarshalBinary(data []byte) error {
+	if len(data)%filterProfileLength != 0 {
+		return fmt.Errorf("unexpected FilterProfiles length: %d is not a multiple of %d", len(data), filterProfileLength)
+	}
+	*f = (*f)[:0]
+	for i := 0; i < len(data); i += filterProfileLength {
+		var profile FilterProfile
+		if err := profile.UnmarshalBinary(data[i : i+filterProfileLength]); err != nil {
+			return err
+		}
+		*f = append(*f, profile)
+	}
+	return nil
+}
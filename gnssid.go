@@ -0,0 +1,16 @@
+package xsens
+
+// GNSSID identifies a GNSS constellation.
+type GNSSID uint8
+
+//go:generate stringer -type GNSSID -trimprefix GNSSID
+
+const (
+	GNSSIDGPS     GNSSID = 0
+	GNSSIDSBAS    GNSSID = 1
+	GNSSIDGalileo GNSSID = 2
+	GNSSIDBeiDou  GNSSID = 3
+	GNSSIDIMES    GNSSID = 4
+	GNSSIDQZSS    GNSSID = 5
+	GNSSIDGLONASS GNSSID = 6
+)
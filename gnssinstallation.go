@@ -0,0 +1,58 @@
+package xsens
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// LatLonAlt is a position in latitude and longitude in degrees, and altitude above the WGS-84 ellipsoid in meters.
+type LatLonAlt struct {
+	Lat, Lon, Alt float64
+}
+
+const (
+	latLonAltLength = 24
+	leverArmLength  = 12
+)
+
+// MarshalBinary returns the wire representation of the position.
+func (l *LatLonAlt) MarshalBinary() ([]byte, error) {
+	result := make([]byte, latLonAltLength)
+	binary.BigEndian.PutUint64(result[0:], math.Float64bits(l.Lat))
+	binary.BigEndian.PutUint64(result[8:], math.Float64bits(l.Lon))
+	binary.BigEndian.PutUint64(result[16:], math.Float64bits(l.Alt))
+	return result, nil
+}
+
+// UnmarshalBinary sets *l from a wire representation of the position.
+func (l *LatLonAlt) UnmarshalBinary(data []byte) error {
+	if n := len(data); n != latLonAltLength {
+		return fmt.Errorf("unexpected LatLonAlt length: want: %d, got: %d", latLonAltLength, n)
+	}
+	l.Lat = math.Float64frombits(binary.BigEndian.Uint64(data[0:]))
+	l.Lon = math.Float64frombits(binary.BigEndian.Uint64(data[8:]))
+	l.Alt = math.Float64frombits(binary.BigEndian.Uint64(data[16:]))
+	return nil
+}
+
+// marshalLeverArm returns the wire representation of a GNSS lever arm.
+func marshalLeverArm(v VectorXYZ) []byte {
+	result := make([]byte, leverArmLength)
+	binary.BigEndian.PutUint32(result[0:], math.Float32bits(float32(v.X)))
+	binary.BigEndian.PutUint32(result[4:], math.Float32bits(float32(v.Y)))
+	binary.BigEndian.PutUint32(result[8:], math.Float32bits(float32(v.Z)))
+	return result
+}
+
+// unmarshalLeverArm returns the GNSS lever arm from its wire representation.
+func unmarshalLeverArm(data []byte) (VectorXYZ, error) {
+	if n := len(data); n != leverArmLength {
+		return VectorXYZ{}, fmt.Errorf("unexpected lever arm length: want: %d, got: %d", leverArmLength, n)
+	}
+	return VectorXYZ{
+		X: float64(math.Float32frombits(binary.BigEndian.Uint32(data[0:]))),
+		Y: float64(math.Float32frombits(binary.BigEndian.Uint32(data[4:]))),
+		Z: float64(math.Float32frombits(binary.BigEndian.Uint32(data[8:]))),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package xsens
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestLatLonAlt_MarshalBinary(t *testing.T) {
+	expected := LatLonAlt{Lat: 57.7089, Lon: 11.9746, Alt: 52.25}
+	data, err := expected.MarshalBinary()
+	assert.NilError(t, err)
+	assert.Equal(t, latLonAltLength, len(data))
+	var actual LatLonAlt
+	assert.NilError(t, actual.UnmarshalBinary(data))
+	assert.Equal(t, expected, actual)
+	assert.ErrorContains(t, actual.UnmarshalBinary(data[:16]), "unexpected LatLonAlt length")
+}
+
+func TestLeverArm_Marshal(t *testing.T) {
+	expected := VectorXYZ{X: 1.25, Y: -0.5, Z: 2}
+	data := marshalLeverArm(expected)
+	assert.Equal(t, leverArmLength, len(data))
+	actual, err := unmarshalLeverArm(data)
+	assert.NilError(t, err)
+	assert.Equal(t, expected, actual)
+	_, err = unmarshalLeverArm(data[:8])
+	assert.ErrorContains(t, err, "unexpected lever arm length")
+}
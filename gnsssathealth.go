@@ -0,0 +1,12 @@
+package xsens
+
+// GNSSSatHealth represents the health of a GNSS satellite.
+type GNSSSatHealth uint8
+
+//go:generate stringer -type GNSSSatHealth -trimprefix GNSSSatHealth
+
+const (
+	GNSSSatHealthUnknown   GNSSSatHealth = 0
+	GNSSSatHealthHealthy   GNSSSatHealth = 1
+	GNSSSatHealthUnhealthy GNSSSatHealth = 2
+)
@@ -0,0 +1,15 @@
+package xsens
+
+// GNSSSignalQuality represents the signal quality indicator of a GNSS satellite.
+type GNSSSignalQuality uint8
+
+//go:generate stringer -type GNSSSignalQuality -trimprefix GNSSSignalQuality
+
+const (
+	GNSSSignalQualityNoSignal             GNSSSignalQuality = 0
+	GNSSSignalQualitySearching            GNSSSignalQuality = 1
+	GNSSSignalQualityAcquired             GNSSSignalQuality = 2
+	GNSSSignalQualityUnusable             GNSSSignalQuality = 3
+	GNSSSignalQualityCodeLocked           GNSSSignalQuality = 4
+	GNSSSignalQualityCodeAndCarrierLocked GNSSSignalQuality = 5
+)
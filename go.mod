@@ -7,6 +7,7 @@ require (
 	github.com/pmezard/go-difflib v1.0.0
 	go.bug.st/serial v1.6.2
 	golang.org/x/sync v0.10.0
+	golang.org/x/sys v0.1.0
 	gotest.tools/v3 v3.5.1
 )
 
@@ -14,6 +15,5 @@ require (
 	github.com/creack/goselect v0.1.2 // indirect
 	github.com/google/go-cmp v0.5.9 // indirect
 	golang.org/x/mod v0.6.0 // indirect
-	golang.org/x/sys v0.1.0 // indirect
 	golang.org/x/tools v0.2.0 // indirect
 )
@@ -13,6 +13,24 @@ type ProductCode string
 
 type HWVersion string // MAJOR.minor
 
+// FirmwareRevision is the firmware revision of an Xsens device.
+type FirmwareRevision struct {
+	Major, Minor, Revision uint8
+	// Build is the build number, not provided by older devices.
+	Build uint32
+	// SVNRevision is the source revision, not provided by older devices.
+	SVNRevision uint32
+}
+
+// DeviceInfo aggregates the identification and self test results of an Xsens device.
+type DeviceInfo struct {
+	DeviceID         DeviceID
+	ProductCode      ProductCode
+	HWVersion        HWVersion
+	FirmwareRevision FirmwareRevision
+	SelfTestResults  SelfTestResults
+}
+
 func (d *DeviceID) UnmarshalBinary(data []byte) error {
 	const mti100 = 4
 	const mti600 = 8
@@ -45,3 +63,30 @@ func (d *HWVersion) UnmarshalBinary(data []byte) error {
 	*d = HWVersion(fmt.Sprintf("%d.%d", data[0], data[1]))
 	return nil
 }
+
+func (d *FirmwareRevision) UnmarshalBinary(data []byte) error {
+	const short = 3
+	const long = 11
+	switch l := len(data); l {
+	case long:
+		d.Build = binary.BigEndian.Uint32(data[3:7])
+		d.SVNRevision = binary.BigEndian.Uint32(data[7:11])
+		fallthrough
+	case short:
+		d.Major = data[0]
+		d.Minor = data[1]
+		d.Revision = data[2]
+	default:
+		return fmt.Errorf("unexpected FirmwareRevision length: want: (%d or %d), got: %d", short, long, l)
+	}
+	return nil
+}
+
+// String returns the firmware revision as MAJOR.minor.revision, followed by the build number and source revision
+// when provided.
+func (d *FirmwareRevision) String() string {
+	if d.Build == 0 && d.SVNRevision == 0 {
+		return fmt.Sprintf("%d.%d.%d", d.Major, d.Minor, d.Revision)
+	}
+	return fmt.Sprintf("%d.%d.%d build %d rev %d", d.Major, d.Minor, d.Revision, d.Build, d.SVNRevision)
+}
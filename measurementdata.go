@@ -3,6 +3,7 @@ package xsens
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"strconv"
@@ -15,6 +16,35 @@ type MeasurementData interface {
 	MarshalMTData2Packet(id DataIdentifier) (MTData2Packet, error)
 }
 
+// RawMeasurement contains the raw data of a measurement data packet with an unsupported data type.
+type RawMeasurement struct {
+	// DataIdentifier is the data identifier of the packet.
+	DataIdentifier DataIdentifier
+
+	// Data is the raw packet data.
+	Data []byte
+}
+
+// String returns a string representation of the raw measurement.
+func (r *RawMeasurement) String() string {
+	return fmt.Sprintf("%v(%s)", r.DataIdentifier, hex.EncodeToString(r.Data))
+}
+
+func (r *RawMeasurement) UnmarshalMTData2Packet(packet MTData2Packet) error {
+	r.DataIdentifier = packet.Identifier()
+	r.Data = append(r.Data[:0], packet.Data()...)
+	return nil
+}
+
+func (r *RawMeasurement) MarshalMTData2Packet(id DataIdentifier) (MTData2Packet, error) {
+	if len(r.Data) > math.MaxUint8 {
+		return nil, fmt.Errorf("RawMeasurement: too much data: %d bytes", len(r.Data))
+	}
+	packet := NewMTData2Package(uint8(len(r.Data)), id)
+	copy(packet.Data(), r.Data)
+	return packet, nil
+}
+
 // Scalar contains a single scalar value.
 type Scalar float64
 
@@ -474,8 +504,16 @@ func (t *LatLon) MarshalMTData2Packet(id DataIdentifier) (MTData2Packet, error)
 // StatusByte contains the 8bit status byte which is equal to bits 0-7 of an MTData2 StatusWord packet.
 type StatusByte uint8
 
+// String returns a structured string representation of the status byte.
 func (t *StatusByte) String() string {
-	return fmt.Sprintf("%08b", *t)
+	return fmt.Sprintf(
+		"{Selftest:%v FilterValid:%v GNSSFix:%v NoRotationUpdateStatus:%v RepresentativeMotion:%v}",
+		t.Selftest(),
+		t.FilterValid(),
+		t.GNSSFix(),
+		t.NoRotationUpdateStatus(),
+		t.RepresentativeMotion(),
+	)
 }
 
 func (t *StatusByte) UnmarshalMTData2Packet(packet MTData2Packet) error {
@@ -561,9 +599,21 @@ func (t *StatusByte) MarshalMTData2Packet(id DataIdentifier) (MTData2Packet, err
 // Reserved for future use.
 type StatusWord uint32
 
-// String returns a string representation of the status word.
+// String returns a structured string representation of the status word.
 func (t *StatusWord) String() string {
-	return fmt.Sprintf("%032b", *t)
+	return fmt.Sprintf(
+		"{Selftest:%v FilterValid:%v GNSSFix:%v NoRotationUpdateStatus:%v RepresentativeMotion:%v ClipFlags:%v "+
+			"SyncInMarker:%v SyncOutMarker:%v FilterMode:%v}",
+		t.Selftest(),
+		t.FilterValid(),
+		t.GNSSFix(),
+		t.NoRotationUpdateStatus(),
+		t.RepresentativeMotion(),
+		t.ClipFlags(),
+		t.SyncInMarker(),
+		t.SyncOutMarker(),
+		t.FilterMode(),
+	)
 }
 
 func (t *StatusWord) UnmarshalMTData2Packet(packet MTData2Packet) error {
@@ -620,6 +670,25 @@ func (u *UTCTime) Time() time.Time {
 	)
 }
 
+const utcTimeLength = 12
+
+// MarshalBinary returns the wire representation of the UTC time, as used by the UTC time messages.
+func (u *UTCTime) MarshalBinary() ([]byte, error) {
+	packet, err := u.MarshalMTData2Packet(DataIdentifier{DataType: DataTypeUTCTime})
+	if err != nil {
+		return nil, err
+	}
+	return packet.Data(), nil
+}
+
+// UnmarshalBinary sets *u from a wire representation of the UTC time, as used by the UTC time messages.
+func (u *UTCTime) UnmarshalBinary(data []byte) error {
+	if l := len(data); l != utcTimeLength {
+		return fmt.Errorf("unexpected UTCTime length: want: %d, got: %d", utcTimeLength, l)
+	}
+	return binary.Read(bytes.NewReader(data), binary.BigEndian, u)
+}
+
 func (u *UTCTime) UnmarshalTime(ts time.Time) {
 	t := ts.UTC()
 	u.Year = uint16(t.Year())
@@ -651,6 +720,60 @@ func (p *PacketCounter) MarshalMTData2Packet(id DataIdentifier) (MTData2Packet,
 	return packet, nil
 }
 
+// ITOW contains the GPS time of week expressed in ms.
+type ITOW uint32
+
+// String returns a string representation of the time of week.
+func (i *ITOW) String() string {
+	return strconv.Itoa(int(*i))
+}
+
+func (i *ITOW) UnmarshalMTData2Packet(packet MTData2Packet) error {
+	return binary.Read(bytes.NewReader(packet.Data()), binary.BigEndian, i)
+}
+
+func (i *ITOW) MarshalMTData2Packet(id DataIdentifier) (MTData2Packet, error) {
+	packet := NewMTData2Package(4, id)
+	binary.BigEndian.PutUint32(packet.Data(), uint32(*i))
+	return packet, nil
+}
+
+// GPSAge contains the age of the GPS data, expressed in number of frames.
+type GPSAge uint8
+
+// String returns a string representation of the age.
+func (g *GPSAge) String() string {
+	return strconv.Itoa(int(*g))
+}
+
+func (g *GPSAge) UnmarshalMTData2Packet(packet MTData2Packet) error {
+	return binary.Read(bytes.NewReader(packet.Data()), binary.BigEndian, g)
+}
+
+func (g *GPSAge) MarshalMTData2Packet(id DataIdentifier) (MTData2Packet, error) {
+	packet := NewMTData2Package(1, id)
+	packet.Data()[0] = uint8(*g)
+	return packet, nil
+}
+
+// PressureAge contains the age of the pressure data, expressed in number of frames.
+type PressureAge uint8
+
+// String returns a string representation of the age.
+func (p *PressureAge) String() string {
+	return strconv.Itoa(int(*p))
+}
+
+func (p *PressureAge) UnmarshalMTData2Packet(packet MTData2Packet) error {
+	return binary.Read(bytes.NewReader(packet.Data()), binary.BigEndian, p)
+}
+
+func (p *PressureAge) MarshalMTData2Packet(id DataIdentifier) (MTData2Packet, error) {
+	packet := NewMTData2Package(1, id)
+	packet.Data()[0] = uint8(*p)
+	return packet, nil
+}
+
 // SampleTimeFine contains the sample time of an output expressed in 10kHz ticks.
 //
 // When there is no GNSS-fix in the MTi-G-710, this value is arbitrary for GNSS messages.
@@ -967,34 +1090,72 @@ type GNSSSatInfo struct {
 
 	// Res3 is reserved for future use.
 	Res3 uint8
+
+	// Satellites contains info on each of the NumSVS satellites.
+	Satellites []GNSSSat
 }
 
+const (
+	gnssSatInfoHeaderLength = 8
+	gnssSatLength           = 4
+)
+
 func (g *GNSSSatInfo) UnmarshalMTData2Packet(packet MTData2Packet) error {
-	return binary.Read(bytes.NewReader(packet.Data()), binary.BigEndian, g)
+	data := packet.Data()
+	if len(data) < gnssSatInfoHeaderLength {
+		return fmt.Errorf("GNSSSatInfo: insufficient data: %d bytes", len(data))
+	}
+	g.ITOW = binary.BigEndian.Uint32(data)
+	g.NumSVS = data[4]
+	g.Res1 = data[5]
+	g.Res2 = data[6]
+	g.Res3 = data[7]
+	data = data[gnssSatInfoHeaderLength:]
+	if len(data) < int(g.NumSVS)*gnssSatLength {
+		return fmt.Errorf("GNSSSatInfo: insufficient data for %d satellites: %d bytes", g.NumSVS, len(data))
+	}
+	g.Satellites = g.Satellites[:0]
+	for i := 0; i < int(g.NumSVS); i++ {
+		sat := data[i*gnssSatLength : (i+1)*gnssSatLength]
+		g.Satellites = append(g.Satellites, GNSSSat{
+			GNSSID: GNSSID(sat[0]),
+			SVID:   sat[1],
+			CNO:    sat[2],
+			Flags:  sat[3],
+		})
+	}
+	return nil
 }
 
 func (g *GNSSSatInfo) MarshalMTData2Packet(id DataIdentifier) (MTData2Packet, error) {
-	packet := NewMTData2Package(8, id)
+	if len(g.Satellites) != int(g.NumSVS) {
+		return nil, fmt.Errorf("GNSSSatInfo: NumSVS %d does not match %d satellites", g.NumSVS, len(g.Satellites))
+	}
+	length := gnssSatInfoHeaderLength + len(g.Satellites)*gnssSatLength
+	if length > math.MaxUint8 {
+		return nil, fmt.Errorf("GNSSSatInfo: too many satellites: %d", len(g.Satellites))
+	}
+	packet := NewMTData2Package(uint8(length), id)
 	packet.SetIdentifier(id)
 	binary.BigEndian.PutUint32(packet.Data(), g.ITOW)
 	packet.Data()[4] = g.NumSVS
 	packet.Data()[5] = g.Res1
 	packet.Data()[6] = g.Res2
 	packet.Data()[7] = g.Res3
+	for i, sat := range g.Satellites {
+		b := packet.Data()[gnssSatInfoHeaderLength+i*gnssSatLength:]
+		b[0] = uint8(sat.GNSSID)
+		b[1] = sat.SVID
+		b[2] = sat.CNO
+		b[3] = sat.Flags
+	}
 	return packet, nil
 }
 
+// GNSSSat contains info on a single GNSS satellite.
 type GNSSSat struct {
 	// GNSSID is the GNSS identifier.
-	//
-	//  0 = GPS
-	//  1 = SBAS
-	//  2 = Galileo
-	//  3 = BeiDou
-	//  4 = IMES
-	//  5 = QZSS
-	//  6 = GLONASS
-	GNSSID uint8
+	GNSSID GNSSID
 
 	// SVID is the satellite identifier.
 	SVID uint8
@@ -1022,3 +1183,427 @@ type GNSSSat struct {
 	//  bit (7) = reserved
 	Flags uint8
 }
+
+const (
+	gnssSatSignalQualityMask      = 0x07
+	gnssSatUsedForNavigationFlag  = 0x08
+	gnssSatHealthMask             = 0x30
+	gnssSatHealthShift            = 4
+	gnssSatDifferentialCorrection = 0x40
+)
+
+// SignalQuality returns the signal quality indicator of the satellite.
+func (s GNSSSat) SignalQuality() GNSSSignalQuality {
+	q := GNSSSignalQuality(s.Flags & gnssSatSignalQualityMask)
+	if q > GNSSSignalQualityCodeAndCarrierLocked {
+		return GNSSSignalQualityCodeAndCarrierLocked
+	}
+	return q
+}
+
+// IsUsedForNavigation returns true if the satellite is being used for navigation.
+func (s GNSSSat) IsUsedForNavigation() bool {
+	return s.Flags&gnssSatUsedForNavigationFlag > 0
+}
+
+// Health returns the health of the satellite.
+func (s GNSSSat) Health() GNSSSatHealth {
+	return GNSSSatHealth((s.Flags & gnssSatHealthMask) >> gnssSatHealthShift)
+}
+
+// HasDifferentialCorrection returns true if differential correction data is available for the satellite.
+func (s GNSSSat) HasDifferentialCorrection() bool {
+	return s.Flags&gnssSatDifferentialCorrection > 0
+}
+
+// GPSDOP contains the dilution of precision of the legacy GPS receiver.
+type GPSDOP struct {
+	// ITOW is the GPS time of week.
+	//
+	//  Unit: ms
+	ITOW uint32
+
+	// GDOP is the geometric DOP.
+	//
+	//  Scale: 0.01
+	GDOP uint16
+
+	// PDOP is the position DOP.
+	//
+	//  Scale: 0.01
+	PDOP uint16
+
+	// TDOP is the time DOP.
+	//
+	//  Scale: 0.01
+	TDOP uint16
+
+	// VDOP is the vertical DOP.
+	//
+	//  Scale: 0.01
+	VDOP uint16
+
+	// HDOP is the horizontal DOP.
+	//
+	//  Scale: 0.01
+	HDOP uint16
+
+	// NDOP is the northing DOP.
+	//
+	//  Scale: 0.01
+	NDOP uint16
+
+	// EDOP is the easting DOP.
+	//
+	//  Scale: 0.01
+	EDOP uint16
+}
+
+func (g *GPSDOP) UnmarshalMTData2Packet(packet MTData2Packet) error {
+	return binary.Read(bytes.NewReader(packet.Data()), binary.BigEndian, g)
+}
+
+func (g *GPSDOP) MarshalMTData2Packet(id DataIdentifier) (MTData2Packet, error) {
+	packet := NewMTData2Package(18, id)
+	binary.BigEndian.PutUint32(packet.Data(), g.ITOW)
+	binary.BigEndian.PutUint16(packet.Data()[4:], g.GDOP)
+	binary.BigEndian.PutUint16(packet.Data()[6:], g.PDOP)
+	binary.BigEndian.PutUint16(packet.Data()[8:], g.TDOP)
+	binary.BigEndian.PutUint16(packet.Data()[10:], g.VDOP)
+	binary.BigEndian.PutUint16(packet.Data()[12:], g.HDOP)
+	binary.BigEndian.PutUint16(packet.Data()[14:], g.NDOP)
+	binary.BigEndian.PutUint16(packet.Data()[16:], g.EDOP)
+	return packet, nil
+}
+
+// GPSSOL contains the navigation solution of the legacy GPS receiver, in ECEF coordinates.
+type GPSSOL struct {
+	// ITOW is the GPS time of week.
+	//
+	//  Unit: ms
+	ITOW uint32
+
+	// FTOW is the fractional nanoseconds remainder of the rounded ms above.
+	//
+	//  Unit: ns
+	FTOW int32
+
+	// Week is the GPS week (GPS time).
+	Week int16
+
+	// GPSFix is the GPS fix type.
+	//
+	//  0x00 = no fix
+	//  0x01 = dead reckoning only
+	//  0x02 = 2D-fix
+	//  0x03 = 3D-fix
+	//  0x04 = GPS + dead reckoning combined
+	//  0x05 = time only fix
+	GPSFix uint8
+
+	// Flags are the fix status flags.
+	//
+	//  bit (0) = GPS fix OK (within DOP and accuracy masks)
+	//  bit (1) = DGPS used
+	//  bit (2) = valid GPS week number
+	//  bit (3) = valid GPS time of week
+	Flags uint8
+
+	// ECEFX is the ECEF X coordinate.
+	//
+	//  Unit: cm
+	ECEFX int32
+
+	// ECEFY is the ECEF Y coordinate.
+	//
+	//  Unit: cm
+	ECEFY int32
+
+	// ECEFZ is the ECEF Z coordinate.
+	//
+	//  Unit: cm
+	ECEFZ int32
+
+	// PAcc is the 3D position accuracy estimate.
+	//
+	//  Unit: cm
+	PAcc uint32
+
+	// ECEFVX is the ECEF X velocity.
+	//
+	//  Unit: cm/s
+	ECEFVX int32
+
+	// ECEFVY is the ECEF Y velocity.
+	//
+	//  Unit: cm/s
+	ECEFVY int32
+
+	// ECEFVZ is the ECEF Z velocity.
+	//
+	//  Unit: cm/s
+	ECEFVZ int32
+
+	// SAcc is the speed accuracy estimate.
+	//
+	//  Unit: cm/s
+	SAcc uint32
+
+	// PDOP is the position DOP.
+	//
+	//  Scale: 0.01
+	PDOP uint16
+
+	// Reserved1 is reserved for future use.
+	Reserved1 uint8
+
+	// NumSV is the number of satellites used in navigation solution.
+	NumSV uint8
+
+	// Reserved2 is reserved for future use.
+	Reserved2 uint32
+}
+
+func (g *GPSSOL) UnmarshalMTData2Packet(packet MTData2Packet) error {
+	return binary.Read(bytes.NewReader(packet.Data()), binary.BigEndian, g)
+}
+
+func (g *GPSSOL) MarshalMTData2Packet(id DataIdentifier) (MTData2Packet, error) {
+	packet := NewMTData2Package(52, id)
+	binary.BigEndian.PutUint32(packet.Data(), g.ITOW)
+	binary.BigEndian.PutUint32(packet.Data()[4:], uint32(g.FTOW))
+	binary.BigEndian.PutUint16(packet.Data()[8:], uint16(g.Week))
+	packet.Data()[10] = g.GPSFix
+	packet.Data()[11] = g.Flags
+	binary.BigEndian.PutUint32(packet.Data()[12:], uint32(g.ECEFX))
+	binary.BigEndian.PutUint32(packet.Data()[16:], uint32(g.ECEFY))
+	binary.BigEndian.PutUint32(packet.Data()[20:], uint32(g.ECEFZ))
+	binary.BigEndian.PutUint32(packet.Data()[24:], g.PAcc)
+	binary.BigEndian.PutUint32(packet.Data()[28:], uint32(g.ECEFVX))
+	binary.BigEndian.PutUint32(packet.Data()[32:], uint32(g.ECEFVY))
+	binary.BigEndian.PutUint32(packet.Data()[36:], uint32(g.ECEFVZ))
+	binary.BigEndian.PutUint32(packet.Data()[40:], g.SAcc)
+	binary.BigEndian.PutUint16(packet.Data()[44:], g.PDOP)
+	packet.Data()[46] = g.Reserved1
+	packet.Data()[47] = g.NumSV
+	binary.BigEndian.PutUint32(packet.Data()[48:], g.Reserved2)
+	return packet, nil
+}
+
+// GPSTimeUTC contains the UTC time of the legacy GPS receiver.
+type GPSTimeUTC struct {
+	// ITOW is the GPS time of week.
+	//
+	//  Unit: ms
+	ITOW uint32
+
+	// TAcc is the time accuracy estimate (UTC).
+	//
+	//  Unit: ns
+	TAcc uint32
+
+	// Nano is the fraction of second -1e-9 .. 1e-9.
+	//
+	//  Unit: ns
+	Nano int32
+
+	// Year (UTC).
+	//
+	//  Unit: y
+	Year uint16
+
+	// Month (UTC).
+	//
+	//  Unit: m
+	Month uint8
+
+	// Day of the month (UTC).
+	//
+	//  Unit: d
+	Day uint8
+
+	// Hour of the day 0..23 (UTC).
+	//
+	//  Unit: h
+	Hour uint8
+
+	// Minute of hour 0..59 (UTC).
+	//
+	//  Unit: min
+	Min uint8
+
+	// Seconds of minute 0..60 (UTC).
+	//
+	//  Unit: s
+	Sec uint8
+
+	// Valid is the validity flags.
+	//
+	//  bit (0) = valid time of week
+	//  bit (1) = valid week number
+	//  bit (2) = valid UTC (leap seconds already known)
+	Valid uint8
+}
+
+// Time returns the native Go representation of the UTC time.
+func (g *GPSTimeUTC) Time() time.Time {
+	return time.Date(
+		int(g.Year),
+		time.Month(g.Month),
+		int(g.Day),
+		int(g.Hour),
+		int(g.Min),
+		int(g.Sec),
+		int(g.Nano),
+		time.UTC,
+	)
+}
+
+func (g *GPSTimeUTC) UnmarshalMTData2Packet(packet MTData2Packet) error {
+	return binary.Read(bytes.NewReader(packet.Data()), binary.BigEndian, g)
+}
+
+func (g *GPSTimeUTC) MarshalMTData2Packet(id DataIdentifier) (MTData2Packet, error) {
+	packet := NewMTData2Package(20, id)
+	binary.BigEndian.PutUint32(packet.Data(), g.ITOW)
+	binary.BigEndian.PutUint32(packet.Data()[4:], g.TAcc)
+	binary.BigEndian.PutUint32(packet.Data()[8:], uint32(g.Nano))
+	binary.BigEndian.PutUint16(packet.Data()[12:], g.Year)
+	packet.Data()[14] = g.Month
+	packet.Data()[15] = g.Day
+	packet.Data()[16] = g.Hour
+	packet.Data()[17] = g.Min
+	packet.Data()[18] = g.Sec
+	packet.Data()[19] = g.Valid
+	return packet, nil
+}
+
+// GPSSVInfo contains info on the channels of the legacy GPS receiver.
+type GPSSVInfo struct {
+	// ITOW is the GPS time of week.
+	//
+	//  Unit: ms
+	ITOW uint32
+
+	// NCh is the number of channels.
+	NCh uint8
+
+	// Res1 is reserved for future use.
+	Res1 uint8
+
+	// Res2 is reserved for future use.
+	Res2 uint16
+
+	// Channels contains info on each of the NCh channels.
+	Channels []GPSSVChannel
+}
+
+const (
+	gpsSVInfoHeaderLength = 8
+	gpsSVChannelLength    = 12
+)
+
+func (g *GPSSVInfo) UnmarshalMTData2Packet(packet MTData2Packet) error {
+	data := packet.Data()
+	if len(data) < gpsSVInfoHeaderLength {
+		return fmt.Errorf("GPSSVInfo: insufficient data: %d bytes", len(data))
+	}
+	g.ITOW = binary.BigEndian.Uint32(data)
+	g.NCh = data[4]
+	g.Res1 = data[5]
+	g.Res2 = binary.BigEndian.Uint16(data[6:])
+	data = data[gpsSVInfoHeaderLength:]
+	if len(data) < int(g.NCh)*gpsSVChannelLength {
+		return fmt.Errorf("GPSSVInfo: insufficient data for %d channels: %d bytes", g.NCh, len(data))
+	}
+	g.Channels = g.Channels[:0]
+	for i := 0; i < int(g.NCh); i++ {
+		ch := data[i*gpsSVChannelLength : (i+1)*gpsSVChannelLength]
+		g.Channels = append(g.Channels, GPSSVChannel{
+			Chn:     ch[0],
+			SVID:    ch[1],
+			Flags:   ch[2],
+			Quality: ch[3],
+			CNO:     ch[4],
+			Elev:    int8(ch[5]),
+			Azim:    int16(binary.BigEndian.Uint16(ch[6:])),
+			PRRes:   int32(binary.BigEndian.Uint32(ch[8:])),
+		})
+	}
+	return nil
+}
+
+func (g *GPSSVInfo) MarshalMTData2Packet(id DataIdentifier) (MTData2Packet, error) {
+	if len(g.Channels) != int(g.NCh) {
+		return nil, fmt.Errorf("GPSSVInfo: NCh %d does not match %d channels", g.NCh, len(g.Channels))
+	}
+	length := gpsSVInfoHeaderLength + len(g.Channels)*gpsSVChannelLength
+	if length > math.MaxUint8 {
+		return nil, fmt.Errorf("GPSSVInfo: too many channels: %d", len(g.Channels))
+	}
+	packet := NewMTData2Package(uint8(length), id)
+	binary.BigEndian.PutUint32(packet.Data(), g.ITOW)
+	packet.Data()[4] = g.NCh
+	packet.Data()[5] = g.Res1
+	binary.BigEndian.PutUint16(packet.Data()[6:], g.Res2)
+	for i, ch := range g.Channels {
+		b := packet.Data()[gpsSVInfoHeaderLength+i*gpsSVChannelLength:]
+		b[0] = ch.Chn
+		b[1] = ch.SVID
+		b[2] = ch.Flags
+		b[3] = ch.Quality
+		b[4] = ch.CNO
+		b[5] = uint8(ch.Elev)
+		binary.BigEndian.PutUint16(b[6:], uint16(ch.Azim))
+		binary.BigEndian.PutUint32(b[8:], uint32(ch.PRRes))
+	}
+	return packet, nil
+}
+
+// GPSSVChannel contains info on a single channel of the legacy GPS receiver.
+type GPSSVChannel struct {
+	// Chn is the channel number.
+	Chn uint8
+
+	// SVID is the satellite identifier.
+	SVID uint8
+
+	// Flags contains the channel flags.
+	//
+	//  bit (0) = SV is used for navigation
+	//  bit (1) = differential correction data is available for this SV
+	//  bit (2) = orbit information is available for this SV (ephemeris or almanac)
+	//  bit (3) = orbit information is ephemeris
+	//  bit (4) = SV is unhealthy / shall not be used
+	Flags uint8
+
+	// Quality is the signal quality indicator.
+	//
+	//  0 = idle
+	//  1 = searching
+	//  2 = signal acquired
+	//  3 = signal detected but unusable
+	//  4 = code lock on signal
+	//  5, 6, 7 = code and carrier locked
+	Quality uint8
+
+	// CNO is the carrier to noise ratio (signal strength).
+	//
+	//  Unit: dBHz
+	CNO uint8
+
+	// Elev is the elevation.
+	//
+	//  Unit: deg
+	Elev int8
+
+	// Azim is the azimuth.
+	//
+	//  Unit: deg
+	Azim int16
+
+	// PRRes is the pseudo range residual.
+	//
+	//  Unit: cm
+	PRRes int32
+}
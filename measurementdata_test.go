@@ -1,6 +1,7 @@
 package xsens
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -459,13 +460,54 @@ func TestConvert_GNSSSatInfo(t *testing.T) {
 				Res1:   3,
 				Res2:   4,
 				Res3:   5,
+				Satellites: []GNSSSat{
+					{GNSSID: GNSSIDGPS, SVID: 6, CNO: 7, Flags: 8},
+					{GNSSID: GNSSIDGalileo, SVID: 9, CNO: 10, Flags: 11},
+				},
 			}
 			data, err := org.MarshalMTData2Packet(tt)
 			assert.NilError(t, err)
 			var n GNSSSatInfo
 			err = n.UnmarshalMTData2Packet(data)
 			assert.NilError(t, err)
-			assert.Equal(t, org, n)
+			assert.DeepEqual(t, org, n)
+		})
+	}
+}
+
+func TestGNSSSat_Flags(t *testing.T) {
+	for _, tt := range []struct {
+		flags                     uint8
+		signalQuality             GNSSSignalQuality
+		usedForNavigation         bool
+		health                    GNSSSatHealth
+		hasDifferentialCorrection bool
+	}{
+		{
+			flags:         0x00,
+			signalQuality: GNSSSignalQualityNoSignal,
+			health:        GNSSSatHealthUnknown,
+		},
+		{
+			flags:             0x1c,
+			signalQuality:     GNSSSignalQualityCodeLocked,
+			usedForNavigation: true,
+			health:            GNSSSatHealthHealthy,
+		},
+		{
+			flags:                     0x67,
+			signalQuality:             GNSSSignalQualityCodeAndCarrierLocked,
+			health:                    GNSSSatHealthUnhealthy,
+			hasDifferentialCorrection: true,
+		},
+	} {
+		tt := tt
+		t.Run(fmt.Sprintf("flags %08b", tt.flags), func(t *testing.T) {
+			sat := GNSSSat{Flags: tt.flags}
+			assert.Equal(t, tt.signalQuality, sat.SignalQuality())
+			assert.Equal(t, tt.usedForNavigation, sat.IsUsedForNavigation())
+			assert.Equal(t, tt.health, sat.Health())
+			assert.Equal(t, tt.hasDifferentialCorrection, sat.HasDifferentialCorrection())
 		})
 	}
 }
@@ -503,6 +545,88 @@ func TestConvert_StatusWord(t *testing.T) {
 	}
 }
 
+func TestStatusWord_Fields(t *testing.T) {
+	for _, tt := range []struct {
+		statusWord             StatusWord
+		selftest               bool
+		filterValid            bool
+		gnssFix                bool
+		noRotationUpdateStatus NoRotationUpdateStatus
+		representativeMotion   bool
+		clipFlags              ClipFlags
+		syncInMarker           bool
+		syncOutMarker          bool
+		filterMode             FilterMode
+		str                    string
+	}{
+		{
+			statusWord: 0,
+			str: "{Selftest:false FilterValid:false GNSSFix:false NoRotationUpdateStatus:Complete " +
+				"RepresentativeMotion:false ClipFlags:None SyncInMarker:false SyncOutMarker:false FilterMode:WithoutGNSS}",
+		},
+		{
+			statusWord:  0x01800007,
+			selftest:    true,
+			filterValid: true,
+			gnssFix:     true,
+			filterMode:  FilterModeWithGNSS,
+			str: "{Selftest:true FilterValid:true GNSSFix:true NoRotationUpdateStatus:Complete " +
+				"RepresentativeMotion:false ClipFlags:None SyncInMarker:false SyncOutMarker:false FilterMode:WithGNSS}",
+		},
+		{
+			statusWord:             0x00e80338,
+			noRotationUpdateStatus: NoRotationUpdateStatusRunning,
+			representativeMotion:   true,
+			clipFlags:              ClipFlagAccX | ClipFlagAccY | ClipFlagClipping,
+			syncInMarker:           true,
+			syncOutMarker:          true,
+			filterMode:             FilterModeCoasting,
+			str: "{Selftest:false FilterValid:false GNSSFix:false NoRotationUpdateStatus:Running " +
+				"RepresentativeMotion:true ClipFlags:AccX|AccY|Clipping SyncInMarker:true SyncOutMarker:true " +
+				"FilterMode:Coasting}",
+		},
+	} {
+		tt := tt
+		t.Run(fmt.Sprintf("%032b", uint32(tt.statusWord)), func(t *testing.T) {
+			w := tt.statusWord
+			assert.Equal(t, tt.selftest, w.Selftest())
+			assert.Equal(t, tt.filterValid, w.FilterValid())
+			assert.Equal(t, tt.gnssFix, w.GNSSFix())
+			assert.Equal(t, tt.noRotationUpdateStatus, w.NoRotationUpdateStatus())
+			assert.Equal(t, tt.representativeMotion, w.RepresentativeMotion())
+			assert.Equal(t, tt.clipFlags, w.ClipFlags())
+			assert.Equal(t, tt.syncInMarker, w.SyncInMarker())
+			assert.Equal(t, tt.syncOutMarker, w.SyncOutMarker())
+			assert.Equal(t, tt.filterMode, w.FilterMode())
+			assert.Equal(t, tt.str, w.String())
+			data, err := json.Marshal(&w)
+			assert.NilError(t, err)
+			var n StatusWord
+			assert.NilError(t, json.Unmarshal(data, &n))
+			assert.Equal(t, w, n)
+		})
+	}
+}
+
+func TestStatusByte_Fields(t *testing.T) {
+	b := StatusByte(0x1b)
+	assert.Assert(t, b.Selftest())
+	assert.Assert(t, b.FilterValid())
+	assert.Assert(t, !b.GNSSFix())
+	assert.Equal(t, NoRotationUpdateStatusRunning, b.NoRotationUpdateStatus())
+	assert.Assert(t, !b.RepresentativeMotion())
+	assert.Equal(
+		t,
+		"{Selftest:true FilterValid:true GNSSFix:false NoRotationUpdateStatus:Running RepresentativeMotion:false}",
+		b.String(),
+	)
+	data, err := json.Marshal(&b)
+	assert.NilError(t, err)
+	var n StatusByte
+	assert.NilError(t, json.Unmarshal(data, &n))
+	assert.Equal(t, b, n)
+}
+
 func TestConvert_BaroPressure(t *testing.T) {
 	const dataType = DataTypeBaroPressure
 	for _, tt := range []DataIdentifier{
@@ -535,3 +659,281 @@ func TestConvert_BaroPressure(t *testing.T) {
 		})
 	}
 }
+
+func TestConvert_ITOW(t *testing.T) {
+	const dataType = DataTypeITOW
+	for _, tt := range []DataIdentifier{
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat32,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1220,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1632,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat64,
+		},
+	} {
+		tt := tt
+		t.Run(fmt.Sprintf("ITOW %v", tt), func(t *testing.T) {
+			org := ITOW(1)
+			data, err := org.MarshalMTData2Packet(tt)
+			assert.NilError(t, err)
+			var n ITOW
+			err = n.UnmarshalMTData2Packet(data)
+			assert.NilError(t, err)
+			assert.Equal(t, org, n)
+		})
+	}
+}
+
+func TestConvert_GPSAge(t *testing.T) {
+	const dataType = DataTypeGPSAge
+	for _, tt := range []DataIdentifier{
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat32,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1220,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1632,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat64,
+		},
+	} {
+		tt := tt
+		t.Run(fmt.Sprintf("GPSAge %v", tt), func(t *testing.T) {
+			org := GPSAge(1)
+			data, err := org.MarshalMTData2Packet(tt)
+			assert.NilError(t, err)
+			var n GPSAge
+			err = n.UnmarshalMTData2Packet(data)
+			assert.NilError(t, err)
+			assert.Equal(t, org, n)
+		})
+	}
+}
+
+func TestConvert_PressureAge(t *testing.T) {
+	const dataType = DataTypePressureAge
+	for _, tt := range []DataIdentifier{
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat32,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1220,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1632,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat64,
+		},
+	} {
+		tt := tt
+		t.Run(fmt.Sprintf("PressureAge %v", tt), func(t *testing.T) {
+			org := PressureAge(1)
+			data, err := org.MarshalMTData2Packet(tt)
+			assert.NilError(t, err)
+			var n PressureAge
+			err = n.UnmarshalMTData2Packet(data)
+			assert.NilError(t, err)
+			assert.Equal(t, org, n)
+		})
+	}
+}
+
+func TestConvert_GPSDOP(t *testing.T) {
+	const dataType = DataTypeGPSDOP
+	for _, tt := range []DataIdentifier{
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat32,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1220,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1632,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat64,
+		},
+	} {
+		tt := tt
+		t.Run(fmt.Sprintf("GPSDOP %v", tt), func(t *testing.T) {
+			org := GPSDOP{
+				ITOW: 1,
+				GDOP: 2,
+				PDOP: 3,
+				TDOP: 4,
+				VDOP: 5,
+				HDOP: 6,
+				NDOP: 7,
+				EDOP: 8,
+			}
+			data, err := org.MarshalMTData2Packet(tt)
+			assert.NilError(t, err)
+			var n GPSDOP
+			err = n.UnmarshalMTData2Packet(data)
+			assert.NilError(t, err)
+			assert.Equal(t, org, n)
+		})
+	}
+}
+
+func TestConvert_GPSSOL(t *testing.T) {
+	const dataType = DataTypeGPSSOL
+	for _, tt := range []DataIdentifier{
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat32,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1220,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1632,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat64,
+		},
+	} {
+		tt := tt
+		t.Run(fmt.Sprintf("GPSSOL %v", tt), func(t *testing.T) {
+			org := GPSSOL{
+				ITOW:      1,
+				FTOW:      -2,
+				Week:      3,
+				GPSFix:    4,
+				Flags:     5,
+				ECEFX:     -6,
+				ECEFY:     7,
+				ECEFZ:     8,
+				PAcc:      9,
+				ECEFVX:    10,
+				ECEFVY:    -11,
+				ECEFVZ:    12,
+				SAcc:      13,
+				PDOP:      14,
+				Reserved1: 15,
+				NumSV:     16,
+				Reserved2: 17,
+			}
+			data, err := org.MarshalMTData2Packet(tt)
+			assert.NilError(t, err)
+			var n GPSSOL
+			err = n.UnmarshalMTData2Packet(data)
+			assert.NilError(t, err)
+			assert.Equal(t, org, n)
+		})
+	}
+}
+
+func TestConvert_GPSTimeUTC(t *testing.T) {
+	const dataType = DataTypeGPSTimeUTC
+	for _, tt := range []DataIdentifier{
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat32,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1220,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1632,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat64,
+		},
+	} {
+		tt := tt
+		t.Run(fmt.Sprintf("GPSTimeUTC %v", tt), func(t *testing.T) {
+			org := GPSTimeUTC{
+				ITOW:  1,
+				TAcc:  2,
+				Nano:  -3,
+				Year:  2019,
+				Month: 4,
+				Day:   5,
+				Hour:  6,
+				Min:   7,
+				Sec:   8,
+				Valid: 9,
+			}
+			data, err := org.MarshalMTData2Packet(tt)
+			assert.NilError(t, err)
+			var n GPSTimeUTC
+			err = n.UnmarshalMTData2Packet(data)
+			assert.NilError(t, err)
+			assert.Equal(t, org, n)
+		})
+	}
+}
+
+func TestConvert_GPSSVInfo(t *testing.T) {
+	const dataType = DataTypeGPSSVInfo
+	for _, tt := range []DataIdentifier{
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat32,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1220,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFP1632,
+		},
+		{
+			DataType:  dataType,
+			Precision: PrecisionFloat64,
+		},
+	} {
+		tt := tt
+		t.Run(fmt.Sprintf("GPSSVInfo %v", tt), func(t *testing.T) {
+			org := GPSSVInfo{
+				ITOW: 1,
+				NCh:  2,
+				Res1: 3,
+				Res2: 4,
+				Channels: []GPSSVChannel{
+					{Chn: 5, SVID: 6, Flags: 7, Quality: 8, CNO: 9, Elev: -10, Azim: 11, PRRes: -12},
+					{Chn: 13, SVID: 14, Flags: 15, Quality: 16, CNO: 17, Elev: 18, Azim: -19, PRRes: 20},
+				},
+			}
+			data, err := org.MarshalMTData2Packet(tt)
+			assert.NilError(t, err)
+			var n GPSSVInfo
+			err = n.UnmarshalMTData2Packet(data)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, org, n)
+		})
+	}
+}
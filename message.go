@@ -79,13 +79,23 @@ type Message []byte
 
 // NewMessage creates a new Xsens message with the provided identifier and data.
 //
-// The provided data can be nil or empty, for messages without any data.
+// The provided data can be nil or empty, for messages without any data. Messages with more than 254 data bytes
+// are created with extended length.
 func NewMessage(mid MessageIdentifier, data []byte) Message {
-	message := make(Message, MinLengthOfMessage+len(data))
+	indexOfData := indexOfData
+	if len(data) >= minLengthOfExtendedData {
+		indexOfData = indexOfExtendedData
+	}
+	message := make(Message, indexOfData+len(data)+lengthOfChecksum)
 	message[indexOfPreamble] = valueOfPreamble
 	message[indexOfBusIdentifier] = valueOfBusIdentifier
 	message[indexOfMessageIdentifier] = uint8(mid)
-	message[indexOfLength] = uint8(len(data))
+	if indexOfData == indexOfExtendedData {
+		message[indexOfLength] = valueOfLengthExtended
+		binary.BigEndian.PutUint16(message[indexOfExtendedLength:], uint16(len(data)))
+	} else {
+		message[indexOfLength] = uint8(len(data))
+	}
 	copy(message[indexOfData:], data)
 	message[len(message)-1] = 0xff & (-message.Checksum())
 	return message
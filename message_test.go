@@ -65,6 +65,22 @@ func TestNewMessage(t *testing.T) {
 	}
 }
 
+func TestNewMessage_Extended(t *testing.T) {
+	data := make([]byte, 300)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	message := xsens.NewMessage(xsens.MessageIdentifierMTData2, data)
+	assert.NilError(t, message.Validate())
+	assert.Assert(t, message.IsExtended())
+	assert.Equal(t, uint16(300), message.Length())
+	assert.DeepEqual(t, data, message.Data())
+	advance, token, err := xsens.ScanMessages(message, true)
+	assert.NilError(t, err)
+	assert.Equal(t, len(message), advance)
+	assert.DeepEqual(t, []byte(message), token)
+}
+
 func TestMessage_Validate_Error(t *testing.T) {
 	for _, tt := range []xsens.Message{
 		{},
@@ -123,6 +123,10 @@ const (
 	MessageIdentifierReqObjectAlignmentAck         MessageIdentifier = 0xE1
 	MessageIdentifierSetObjectAlignment            MessageIdentifier = 0xE0
 	MessageIdentifierSetObjectAlignmentAck         MessageIdentifier = 0xE1
+	MessageIdentifierReqAlignmentRotation          MessageIdentifier = 0xEC
+	MessageIdentifierReqAlignmentRotationAck       MessageIdentifier = 0xED
+	MessageIdentifierSetAlignmentRotation          MessageIdentifier = 0xEC
+	MessageIdentifierSetAlignmentRotationAck       MessageIdentifier = 0xED
 	MessageIdentifierReqXmErrorMode                MessageIdentifier = 0x82
 	MessageIdentifierReqXmErrorModeAck             MessageIdentifier = 0x83
 	MessageIdentifierSetXmErrorMode                MessageIdentifier = 0x82
@@ -115,6 +115,10 @@ func _() {
 	_ = x[MessageIdentifierReqObjectAlignmentAck-225]
 	_ = x[MessageIdentifierSetObjectAlignment-224]
 	_ = x[MessageIdentifierSetObjectAlignmentAck-225]
+	_ = x[MessageIdentifierReqAlignmentRotation-236]
+	_ = x[MessageIdentifierReqAlignmentRotationAck-237]
+	_ = x[MessageIdentifierSetAlignmentRotation-236]
+	_ = x[MessageIdentifierSetAlignmentRotationAck-237]
 	_ = x[MessageIdentifierReqXmErrorMode-130]
 	_ = x[MessageIdentifierReqXmErrorModeAck-131]
 	_ = x[MessageIdentifierSetXmErrorMode-130]
@@ -273,7 +277,7 @@ func _() {
 	_ = x[MessageIdentifierReqCANOutputConfigAck-233]
 }
 
-const _MessageIdentifier_name = "ReqDIDDeviceIDInitBusInitBusResultsReqPeriodReqPeriodAckSetBidSetBidAckBusPowerBusPowerAckReqDataLengthDataLengthReqConfigurationConfigurationRestoreFactoryDefRestoreFactoryDefAckGotoMeasurementGotoMeasurementAckReqFirmwareRevisionFirmwareRevisionReqBluetoothDisableReqBluetoothDisableAckReqXmOutputModeReqXmOutputModeAckReqBaudrateReqBaudrateAckReqSyncModeReqSyncModeAckReqProductCodeProductCodeReqHWVersionHWVersionReqProcessingFlagsReqProcessingFlagsAckSetNoRotationSetNoRotationAckRunSelfTestSelfTestResultsReqInputTriggerReqInputTriggerAckReqOutputTriggerReqOutputTriggerAckSetSyncBoxModeSetSyncBoxModeAckSetSyncConfigurationSetSyncConfigurationAckDriverDisconnectDriverDisconnectAckGotoConfigGotoConfigAckBusDataReqDataReqDataAckMTData2WakeupWakeupAckResetResetAckErrorXmPowerOffMasterIndicationInfoBatteryLevelInfoTemperatureGotoTransparentModeGotoTransparentModeAckSetUtcTimeSetUtcTimeAckReqAvailableFilterProfilesAvailableFilterProfilesReqFilterProfileReqFilterProfileAckReqGravityMagnitudeReqGravityMagnitudeAckReqGpsLeverArmReqGpsLeverArmAckReqMagneticFieldReqMagneticFieldAckReqLatLonAltReqLatLonAltAckReqXmErrorModeReqXmErrorModeAckReqBufferSizeReqBufferSizeAckReqExtOutputModeReqExtOutputModeAckReqBatteryLevelBatterylevelReqMasterSettingsMasterSettingsReqEmtsEmtsDataRestoreEmtsRestoreEmtsAckStoreEmtsStoreEmtsAckReqActiveClockCorrectionActiveClockCorrectionStoreActiveClockCorrectionStoreActiveClockCorrectionAckReqFilterSettingsReqFilterSettingsAckReqAmdReqAmdAckResetOrientationResetOrientationAckReqGpsStatusGpsStatusAdjustUtcTimeAdjustUtcTimeAckWriteDeviceIDWriteDeviceIDAckWriteSecurityKeyWriteSecurityKeyAckProtectFlashProtectFlashAckReqSecurityCheckSecurityCheckSetClientPrioritySetClientPriorityAckSetWirelessConfigSetWirelessConfigAckUpdateBiasUpdateBiasAckToggleIoPinsToggleIoPinsAckReqOutputConfigurationReqOutputConfigurationAckSetTransportModeSetTransportModeAckAcceptMtwAcceptMtwAckRejectMtwRejectMtwAckInfoRequestInfoRequestAckReqFrameRatesReqFrameRatesAckStartRecordingStartRecordingAckStopRecordingStopRecordingAckReqOutputModeReqOutputModeAckReqOutputSettingsReqOutputSettingsAckReqOutputSkipFactorReqOutputSkipFactorAckReqSyncInSettingsReqSyncInSettingsAckReqSyncOutSettingsReqSyncOutSettingsAckReqErrorModeReqErrorModeAckReqTransmitDelayReqTransmitDelayAckSetMfmResultsSetMfmResultsAckReqObjectAlignmentReqObjectAlignmentAckSetCANConfigSetCANConfigAckSetCANOutputConfigSetCANOutputConfigAck"
+const _MessageIdentifier_name = "ReqDIDDeviceIDInitBusInitBusResultsReqPeriodReqPeriodAckSetBidSetBidAckBusPowerBusPowerAckReqDataLengthDataLengthReqConfigurationConfigurationRestoreFactoryDefRestoreFactoryDefAckGotoMeasurementGotoMeasurementAckReqFirmwareRevisionFirmwareRevisionReqBluetoothDisableReqBluetoothDisableAckReqXmOutputModeReqXmOutputModeAckReqBaudrateReqBaudrateAckReqSyncModeReqSyncModeAckReqProductCodeProductCodeReqHWVersionHWVersionReqProcessingFlagsReqProcessingFlagsAckSetNoRotationSetNoRotationAckRunSelfTestSelfTestResultsReqInputTriggerReqInputTriggerAckReqOutputTriggerReqOutputTriggerAckSetSyncBoxModeSetSyncBoxModeAckSetSyncConfigurationSetSyncConfigurationAckDriverDisconnectDriverDisconnectAckGotoConfigGotoConfigAckBusDataReqDataReqDataAckMTData2WakeupWakeupAckResetResetAckErrorXmPowerOffMasterIndicationInfoBatteryLevelInfoTemperatureGotoTransparentModeGotoTransparentModeAckSetUtcTimeSetUtcTimeAckReqAvailableFilterProfilesAvailableFilterProfilesReqFilterProfileReqFilterProfileAckReqGravityMagnitudeReqGravityMagnitudeAckReqGpsLeverArmReqGpsLeverArmAckReqMagneticFieldReqMagneticFieldAckReqLatLonAltReqLatLonAltAckReqXmErrorModeReqXmErrorModeAckReqBufferSizeReqBufferSizeAckReqExtOutputModeReqExtOutputModeAckReqBatteryLevelBatterylevelReqMasterSettingsMasterSettingsReqEmtsEmtsDataRestoreEmtsRestoreEmtsAckStoreEmtsStoreEmtsAckReqActiveClockCorrectionActiveClockCorrectionStoreActiveClockCorrectionStoreActiveClockCorrectionAckReqFilterSettingsReqFilterSettingsAckReqAmdReqAmdAckResetOrientationResetOrientationAckReqGpsStatusGpsStatusAdjustUtcTimeAdjustUtcTimeAckWriteDeviceIDWriteDeviceIDAckWriteSecurityKeyWriteSecurityKeyAckProtectFlashProtectFlashAckReqSecurityCheckSecurityCheckSetClientPrioritySetClientPriorityAckSetWirelessConfigSetWirelessConfigAckUpdateBiasUpdateBiasAckToggleIoPinsToggleIoPinsAckReqOutputConfigurationReqOutputConfigurationAckSetTransportModeSetTransportModeAckAcceptMtwAcceptMtwAckRejectMtwRejectMtwAckInfoRequestInfoRequestAckReqFrameRatesReqFrameRatesAckStartRecordingStartRecordingAckStopRecordingStopRecordingAckReqOutputModeReqOutputModeAckReqOutputSettingsReqOutputSettingsAckReqOutputSkipFactorReqOutputSkipFactorAckReqSyncInSettingsReqSyncInSettingsAckReqSyncOutSettingsReqSyncOutSettingsAckReqErrorModeReqErrorModeAckReqTransmitDelayReqTransmitDelayAckSetMfmResultsSetMfmResultsAckReqObjectAlignmentReqObjectAlignmentAckSetCANConfigSetCANConfigAckSetCANOutputConfigSetCANOutputConfigAckReqAlignmentRotationReqAlignmentRotationAck"
 
 var _MessageIdentifier_map = map[MessageIdentifier]string{
 	0:   _MessageIdentifier_name[0:6],
@@ -439,6 +443,8 @@ var _MessageIdentifier_map = map[MessageIdentifier]string{
 	231: _MessageIdentifier_name[2372:2387],
 	232: _MessageIdentifier_name[2387:2405],
 	233: _MessageIdentifier_name[2405:2426],
+	236: _MessageIdentifier_name[2426:2446],
+	237: _MessageIdentifier_name[2446:2469],
 }
 
 func (i MessageIdentifier) String() string {
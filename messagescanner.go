@@ -0,0 +1,83 @@
+package xsens
+
+import (
+	"errors"
+	"io"
+)
+
+const (
+	// messageScannerBufferSize is the buffer size of a message scanner, fitting the largest extended message.
+	messageScannerBufferSize = 2 * (indexOfExtendedData + maxLengthOfExtendedData + lengthOfChecksum)
+	// maxConsecutiveEmptyReads is the number of empty reads after which a message scanner gives up.
+	maxConsecutiveEmptyReads = 100
+)
+
+// messageScanner scans Xsens messages from a reader.
+//
+// Unlike a bufio.Scanner, read errors do not stop the scanner and buffered data is kept across errors, so that
+// scanning can continue after a read has been aborted.
+type messageScanner struct {
+	r     io.Reader
+	buf   []byte
+	start int
+	end   int
+	token []byte
+	err   error
+}
+
+// newMessageScanner returns a new message scanner reading from r.
+func newMessageScanner(r io.Reader) *messageScanner {
+	return &messageScanner{r: r, buf: make([]byte, messageScannerBufferSize)}
+}
+
+// Scan advances the scanner to the next message, which is then available through Bytes.
+//
+// Returns false when a read fails, the error then being available through Err.
+func (s *messageScanner) Scan() bool {
+	s.token = nil
+	s.err = nil
+	for emptyReads := 0; ; {
+		if s.end > s.start {
+			advance, token, _ := ScanMessages(s.buf[s.start:s.end], false)
+			s.start += advance
+			if token != nil {
+				s.token = token
+				return true
+			}
+		}
+		// move buffered data to the start of the buffer, a partial message always fits the remaining space
+		if s.start > 0 {
+			copy(s.buf, s.buf[s.start:s.end])
+			s.end -= s.start
+			s.start = 0
+		}
+		n, err := s.r.Read(s.buf[s.end:])
+		s.end += n
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				s.err = err
+			}
+			return false
+		}
+		if n > 0 {
+			emptyReads = 0
+			continue
+		}
+		if emptyReads++; emptyReads >= maxConsecutiveEmptyReads {
+			s.err = io.ErrNoProgress
+			return false
+		}
+	}
+}
+
+// Bytes returns the most recent message scanned by Scan.
+//
+// The underlying array may be overwritten by a subsequent call to Scan.
+func (s *messageScanner) Bytes() []byte {
+	return s.token
+}
+
+// Err returns the error of the most recent call to Scan, nil at end of input.
+func (s *messageScanner) Err() error {
+	return s.err
+}
@@ -0,0 +1,23 @@
+package xsens
+
+// NoRotationUpdateStatus represents the status of the no rotation update procedure reported in a StatusWord.
+type NoRotationUpdateStatus uint8
+
+//go:generate stringer -type NoRotationUpdateStatus -trimprefix NoRotationUpdateStatus
+
+const (
+	// NoRotationUpdateStatusComplete is reported when the gyro bias estimation is complete, without errors.
+	NoRotationUpdateStatusComplete NoRotationUpdateStatus = 0x0
+
+	// NoRotationUpdateStatusRotationDetected is reported when rotation was detected and no gyro bias estimation
+	// was performed.
+	NoRotationUpdateStatusRotationDetected NoRotationUpdateStatus = 0x2
+
+	// NoRotationUpdateStatusRunning is reported when the device is running with the no rotation assumption.
+	NoRotationUpdateStatusRunning NoRotationUpdateStatus = 0x3
+)
+
+// MarshalText implements encoding.TextMarshaler.
+func (n NoRotationUpdateStatus) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
@@ -4,8 +4,12 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"strings"
 )
 
+// maxOutputConfigurationSettings is the maximum number of settings in an output configuration.
+const maxOutputConfigurationSettings = 32
+
 // OutputConfiguration is measurement data output configuration.
 //
 // The data is a list of maximum 32 data identifiers combined with a desired output frequency.
@@ -55,6 +59,131 @@ func (o *OutputConfiguration) MarshalText() (string, error) {
 	return buf.String(), nil
 }
 
+// Validate checks the output configuration before it is sent to a device.
+//
+// Validate checks the number of settings, duplicate data types, precisions of data types without configurable
+// precision and the total MTData2 payload size. When configured with a product family, the output frequencies are
+// also checked against the base frequency of the product family.
+//
+// Returns OutputConfigurationErrors describing every invalid setting, or nil if the configuration is valid.
+func (o *OutputConfiguration) Validate(options ...ValidationOption) error {
+	opts := defaultValidationOptions()
+	for _, option := range options {
+		option(opts)
+	}
+	var errs OutputConfigurationErrors
+	addError := func(index int, reason string, args ...interface{}) {
+		err := &OutputConfigurationError{Index: index, Reason: fmt.Sprintf(reason, args...)}
+		if index >= 0 {
+			err.Setting = (*o)[index]
+		}
+		errs = append(errs, err)
+	}
+	if len(*o) > maxOutputConfigurationSettings {
+		addError(-1, "%d settings exceeds the maximum of %d", len(*o), maxOutputConfigurationSettings)
+	}
+	dataTypes := make(map[DataType]int, len(*o))
+	payloadSize := 0
+	for i, setting := range *o {
+		if j, ok := dataTypes[setting.DataType]; ok {
+			addError(i, "duplicate data type, also configured by setting %d", j)
+		} else {
+			dataTypes[setting.DataType] = i
+		}
+		if !setting.DataType.HasPrecision() && setting.Precision != PrecisionFloat32 {
+			addError(i, "precision %v is not supported by data type %v", setting.Precision, setting.DataType)
+		}
+		dataSize := setting.DataSize()
+		if dataSize == 0 {
+			addError(i, "unsupported data type %v", setting.DataType)
+		}
+		payloadSize += packetDataStart + int(dataSize)
+		validateOutputFrequency(opts.productFamily, setting, func(reason string, args ...interface{}) {
+			addError(i, reason, args...)
+		})
+	}
+	if payloadSize > maxLengthOfExtendedData {
+		addError(-1, "MTData2 payload size %d exceeds the maximum of %d bytes", payloadSize, maxLengthOfExtendedData)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateOutputFrequency checks the output frequency of the setting against the product family.
+func validateOutputFrequency(
+	family ProductFamily,
+	setting OutputConfigurationSetting,
+	addError func(reason string, args ...interface{}),
+) {
+	if setting.OutputFrequency == 0 || setting.OutputFrequency == MaxOutputFrequency {
+		return
+	}
+	switch setting.DataType {
+	case DataTypeAccelerationHR, DataTypeRateOfTurnHR:
+		if maxFrequency := family.MaxHighRateFrequency(); maxFrequency != 0 && setting.OutputFrequency > maxFrequency {
+			addError("%v exceeds the maximum frequency %v of %v", setting.OutputFrequency, maxFrequency, family)
+		}
+	default:
+		if baseFrequency := family.BaseFrequency(); baseFrequency != 0 && baseFrequency%setting.OutputFrequency != 0 {
+			addError("%v is not a divisor of the base frequency %v of %v", setting.OutputFrequency, baseFrequency, family)
+		}
+	}
+}
+
+type validationOptions struct {
+	// productFamily is the product family to validate output frequencies against.
+	productFamily ProductFamily
+}
+
+// defaultValidationOptions returns validationOptions with sensible default values.
+func defaultValidationOptions() *validationOptions {
+	return &validationOptions{
+		productFamily: ProductFamilyUnknown,
+	}
+}
+
+// ValidationOption configures the validation of an output configuration.
+type ValidationOption func(*validationOptions)
+
+// WithProductFamily configures the product family to validate output frequencies against.
+func WithProductFamily(family ProductFamily) ValidationOption {
+	return func(opt *validationOptions) {
+		opt.productFamily = family
+	}
+}
+
+// OutputConfigurationError is an invalid setting of an output configuration.
+type OutputConfigurationError struct {
+	// Index is the index of the invalid setting, or -1 if the error concerns the whole output configuration.
+	Index int
+	// Setting is the invalid setting.
+	Setting OutputConfigurationSetting
+	// Reason describes why the setting is invalid.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *OutputConfigurationError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("invalid output configuration: %s", e.Reason)
+	}
+	return fmt.Sprintf("invalid output configuration setting %d (%v): %s", e.Index, e.Setting.DataIdentifier, e.Reason)
+}
+
+// OutputConfigurationErrors are the errors found when validating an output configuration.
+type OutputConfigurationErrors []*OutputConfigurationError
+
+// Error implements the error interface.
+func (e OutputConfigurationErrors) Error() string {
+	errs := make([]string, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err.Error())
+	}
+	return strings.Join(errs, "; ")
+}
+
 // OutputFrequency represents the output frequency of a specific Xsens measurement data type.
 type OutputFrequency uint16
 
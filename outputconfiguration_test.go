@@ -1,6 +1,7 @@
 package xsens_test
 
 import (
+	"errors"
 	"os"
 	"testing"
 
@@ -57,3 +58,140 @@ func TestOutputConfiguration_MarshalText_TestData(t *testing.T) {
 		})
 	}
 }
+
+func TestOutputConfiguration_Validate_TestData(t *testing.T) {
+	for _, inputFile := range []string{
+		"testdata/1/outputconfig.bin",
+		"testdata/2/outputconfig.bin",
+		"testdata/3/outputconfig.bin",
+		"testdata/4/outputconfig.bin",
+		"testdata/5/outputconfig.bin",
+	} {
+		inputFile := inputFile
+		t.Run(inputFile, func(t *testing.T) {
+			input, err := os.ReadFile(inputFile)
+			assert.NilError(t, err)
+			var outputConfiguration xsens.OutputConfiguration
+			assert.NilError(t, outputConfiguration.Unmarshal(input))
+			assert.NilError(t, outputConfiguration.Validate())
+		})
+	}
+}
+
+func TestOutputConfiguration_Validate(t *testing.T) {
+	quaternion := xsens.DataIdentifier{DataType: xsens.DataTypeQuaternion, Precision: xsens.PrecisionFloat64}
+	for _, tt := range []struct {
+		name                string
+		outputConfiguration xsens.OutputConfiguration
+		options             []xsens.ValidationOption
+		expected            xsens.OutputConfigurationErrors
+	}{
+		{
+			name: "valid",
+			outputConfiguration: xsens.OutputConfiguration{
+				{DataIdentifier: xsens.DataIdentifier{DataType: xsens.DataTypeSampleTimeFine}, OutputFrequency: 0xffff},
+				{DataIdentifier: quaternion, OutputFrequency: 100},
+				{DataIdentifier: xsens.DataIdentifier{DataType: xsens.DataTypeRateOfTurnHR}, OutputFrequency: 1600},
+			},
+			options: []xsens.ValidationOption{xsens.WithProductFamily(xsens.ProductFamilyMTi600)},
+		},
+		{
+			name: "duplicate data type",
+			outputConfiguration: xsens.OutputConfiguration{
+				{DataIdentifier: quaternion, OutputFrequency: 100},
+				{DataIdentifier: xsens.DataIdentifier{DataType: xsens.DataTypeQuaternion}, OutputFrequency: 10},
+			},
+			expected: xsens.OutputConfigurationErrors{
+				{
+					Index: 1,
+					Setting: xsens.OutputConfigurationSetting{
+						DataIdentifier:  xsens.DataIdentifier{DataType: xsens.DataTypeQuaternion},
+						OutputFrequency: 10,
+					},
+					Reason: "duplicate data type, also configured by setting 0",
+				},
+			},
+		},
+		{
+			name: "unsupported precision",
+			outputConfiguration: xsens.OutputConfiguration{
+				{
+					DataIdentifier: xsens.DataIdentifier{
+						DataType:  xsens.DataTypePacketCounter,
+						Precision: xsens.PrecisionFloat64,
+					},
+					OutputFrequency: 0xffff,
+				},
+			},
+			expected: xsens.OutputConfigurationErrors{
+				{
+					Index: 0,
+					Setting: xsens.OutputConfigurationSetting{
+						DataIdentifier: xsens.DataIdentifier{
+							DataType:  xsens.DataTypePacketCounter,
+							Precision: xsens.PrecisionFloat64,
+						},
+						OutputFrequency: 0xffff,
+					},
+					Reason: "precision Float64 is not supported by data type PacketCounter",
+				},
+			},
+		},
+		{
+			name: "frequencies",
+			outputConfiguration: xsens.OutputConfiguration{
+				{DataIdentifier: quaternion, OutputFrequency: 30},
+				{DataIdentifier: xsens.DataIdentifier{DataType: xsens.DataTypeAccelerationHR}, OutputFrequency: 1200},
+			},
+			options: []xsens.ValidationOption{xsens.WithProductFamily(xsens.ProductFamilyMTi1)},
+			expected: xsens.OutputConfigurationErrors{
+				{
+					Index:   0,
+					Setting: xsens.OutputConfigurationSetting{DataIdentifier: quaternion, OutputFrequency: 30},
+					Reason:  "30 Hz is not a divisor of the base frequency 100 Hz of MTi1",
+				},
+				{
+					Index: 1,
+					Setting: xsens.OutputConfigurationSetting{
+						DataIdentifier:  xsens.DataIdentifier{DataType: xsens.DataTypeAccelerationHR},
+						OutputFrequency: 1200,
+					},
+					Reason: "1200 Hz exceeds the maximum frequency 1000 Hz of MTi1",
+				},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.outputConfiguration.Validate(tt.options...)
+			if tt.expected == nil {
+				assert.NilError(t, err)
+				return
+			}
+			var actual xsens.OutputConfigurationErrors
+			assert.Assert(t, errors.As(err, &actual))
+			assert.DeepEqual(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestOutputConfiguration_Validate_Size(t *testing.T) {
+	var outputConfiguration xsens.OutputConfiguration
+	for i := 0; i < 33; i++ {
+		outputConfiguration = append(outputConfiguration, xsens.OutputConfigurationSetting{
+			DataIdentifier: xsens.DataIdentifier{
+				DataType:  xsens.DataTypeRotationMatrix,
+				Precision: xsens.PrecisionFloat64,
+			},
+			OutputFrequency: 100,
+		})
+	}
+	err := outputConfiguration.Validate()
+	assert.ErrorContains(t, err, "invalid output configuration: 33 settings exceeds the maximum of 32")
+	assert.ErrorContains(
+		t, err, "invalid output configuration: MTData2 payload size 2475 exceeds the maximum of 2048 bytes",
+	)
+	assert.ErrorContains(
+		t, err, "invalid output configuration setting 32 (RotationMatrix(EastNorthUp,Float64)): duplicate data type",
+	)
+}
@@ -0,0 +1,15 @@
+package xsens
+
+// OverflowPolicy determines how a subscription handles data when its buffer is full.
+type OverflowPolicy uint8
+
+//go:generate stringer -type OverflowPolicy -trimprefix OverflowPolicy
+
+const (
+	// OverflowPolicyDropOldest drops the oldest buffered data to make room for new data.
+	OverflowPolicyDropOldest OverflowPolicy = iota
+	// OverflowPolicyBlock blocks the stream until the subscriber has made room for new data.
+	//
+	// A blocked subscription stalls all other subscriptions of the stream.
+	OverflowPolicyBlock
+)
@@ -0,0 +1,84 @@
+package xsens
+
+import (
+	"strconv"
+	"strings"
+)
+
+//go:generate stringer -type ProductFamily -trimprefix ProductFamily
+
+// ProductFamily is a family of Xsens products sharing output capabilities.
+type ProductFamily uint8
+
+const (
+	// ProductFamilyUnknown is an unknown product family.
+	ProductFamilyUnknown ProductFamily = iota
+	// ProductFamilyMTi1 is the MTi 1-series (MTi-1, MTi-2, MTi-3, MTi-7, MTi-8).
+	ProductFamilyMTi1
+	// ProductFamilyMTi10 is the MTi 10-series (MTi-10, MTi-20, MTi-30).
+	ProductFamilyMTi10
+	// ProductFamilyMTi100 is the MTi 100-series (MTi-100, MTi-200, MTi-300, MTi-G-710).
+	ProductFamilyMTi100
+	// ProductFamilyMTi600 is the MTi 600-series (MTi-610 to MTi-680G).
+	ProductFamilyMTi600
+)
+
+// BaseFrequency returns the base output frequency of the product family.
+//
+// The output frequency of data other than high-rate data must be a divisor of the base frequency.
+// Returns 0 for unknown product families.
+func (f ProductFamily) BaseFrequency() OutputFrequency {
+	switch f {
+	case ProductFamilyMTi1:
+		return 100
+	case ProductFamilyMTi10, ProductFamilyMTi100, ProductFamilyMTi600:
+		return 400
+	}
+	return 0
+}
+
+// MaxHighRateFrequency returns the maximum output frequency of high-rate data (AccelerationHR, RateOfTurnHR) of
+// the product family.
+//
+// Returns 0 for unknown product families.
+func (f ProductFamily) MaxHighRateFrequency() OutputFrequency {
+	switch f {
+	case ProductFamilyMTi1:
+		return 1000
+	case ProductFamilyMTi10, ProductFamilyMTi100, ProductFamilyMTi600:
+		return 2000
+	}
+	return 0
+}
+
+// ProductFamily returns the product family of the product code, such as ProductFamilyMTi600 for "MTi-630-2A8G4".
+//
+// Returns ProductFamilyUnknown for unrecognized product codes.
+func (d ProductCode) ProductFamily() ProductFamily {
+	s := strings.TrimPrefix(string(d), "MTi-")
+	if len(s) == len(d) {
+		return ProductFamilyUnknown
+	}
+	s = strings.TrimPrefix(s, "G-")
+	digits := strings.IndexFunc(s, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if digits == -1 {
+		digits = len(s)
+	}
+	model, err := strconv.Atoi(s[:digits])
+	if err != nil {
+		return ProductFamilyUnknown
+	}
+	switch {
+	case model >= 1 && model <= 9:
+		return ProductFamilyMTi1
+	case model == 10 || model == 20 || model == 30:
+		return ProductFamilyMTi10
+	case model == 100 || model == 200 || model == 300 || model == 710:
+		return ProductFamilyMTi100
+	case model >= 600 && model <= 699:
+		return ProductFamilyMTi600
+	}
+	return ProductFamilyUnknown
+}
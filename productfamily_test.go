@@ -0,0 +1,29 @@
+package xsens
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestProductCode_ProductFamily(t *testing.T) {
+	for _, tt := range []struct {
+		productCode ProductCode
+		expected    ProductFamily
+	}{
+		{productCode: "MTi-3-8A7G6", expected: ProductFamilyMTi1},
+		{productCode: "MTi-30-2A8G4", expected: ProductFamilyMTi10},
+		{productCode: "MTi-300-2A8G4", expected: ProductFamilyMTi100},
+		{productCode: "MTi-G-710-2A8G4", expected: ProductFamilyMTi100},
+		{productCode: "MTi-630-2A8G4", expected: ProductFamilyMTi600},
+		{productCode: "MTi-680G", expected: ProductFamilyMTi600},
+		{productCode: "MTi-G-700", expected: ProductFamilyUnknown},
+		{productCode: "MTw", expected: ProductFamilyUnknown},
+		{productCode: "", expected: ProductFamilyUnknown},
+	} {
+		tt := tt
+		t.Run(string(tt.productCode), func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.productCode.ProductFamily())
+		})
+	}
+}
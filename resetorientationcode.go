@@ -0,0 +1,35 @@
+package xsens
+
+// ResetOrientationCode identifies the kind of orientation reset performed by the device.
+type ResetOrientationCode uint16
+
+//go:generate stringer -type ResetOrientationCode -trimprefix ResetOrientationCode
+
+const (
+	// ResetOrientationCodeStore stores the current orientation reset settings, only available in config mode.
+	ResetOrientationCodeStore ResetOrientationCode = 0x0000
+
+	// ResetOrientationCodeHeading resets the heading, aligning the local frame's X-axis with the object frame's
+	// X-axis projected on the horizontal plane.
+	ResetOrientationCodeHeading ResetOrientationCode = 0x0001
+
+	// ResetOrientationCodeInclination resets the inclination, aligning the object frame with the horizontal plane.
+	ResetOrientationCodeInclination ResetOrientationCode = 0x0003
+
+	// ResetOrientationCodeAlignment resets both the inclination and the heading.
+	ResetOrientationCodeAlignment ResetOrientationCode = 0x0004
+
+	// ResetOrientationCodeDefaultHeading reverts the heading reset to its default.
+	ResetOrientationCodeDefaultHeading ResetOrientationCode = 0x0005
+
+	// ResetOrientationCodeDefaultInclination reverts the inclination reset to its default.
+	ResetOrientationCodeDefaultInclination ResetOrientationCode = 0x0006
+
+	// ResetOrientationCodeDefaultAlignment reverts the alignment reset to its default.
+	ResetOrientationCodeDefaultAlignment ResetOrientationCode = 0x0007
+)
+
+// MarshalText implements encoding.TextMarshaler.
+func (r ResetOrientationCode) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
@@ -0,0 +1,124 @@
+package xsens
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SelfTestResults represents the results of a self test, with a bit set for each passed test.
+type SelfTestResults uint16
+
+const (
+	SelfTestAccX    SelfTestResults = 1 << 0
+	SelfTestAccY    SelfTestResults = 1 << 1
+	SelfTestAccZ    SelfTestResults = 1 << 2
+	SelfTestGyrX    SelfTestResults = 1 << 3
+	SelfTestGyrY    SelfTestResults = 1 << 4
+	SelfTestGyrZ    SelfTestResults = 1 << 5
+	SelfTestMagX    SelfTestResults = 1 << 6
+	SelfTestMagY    SelfTestResults = 1 << 7
+	SelfTestMagZ    SelfTestResults = 1 << 8
+	SelfTestBaro    SelfTestResults = 1 << 9
+	SelfTestGNSS    SelfTestResults = 1 << 10
+	SelfTestBattery SelfTestResults = 1 << 11
+	SelfTestFlash   SelfTestResults = 1 << 12
+	SelfTestButton  SelfTestResults = 1 << 13
+	SelfTestSync    SelfTestResults = 1 << 14
+)
+
+var selfTestNames = []struct {
+	test SelfTestResults
+	name string
+}{
+	{test: SelfTestAccX, name: "AccX"},
+	{test: SelfTestAccY, name: "AccY"},
+	{test: SelfTestAccZ, name: "AccZ"},
+	{test: SelfTestGyrX, name: "GyrX"},
+	{test: SelfTestGyrY, name: "GyrY"},
+	{test: SelfTestGyrZ, name: "GyrZ"},
+	{test: SelfTestMagX, name: "MagX"},
+	{test: SelfTestMagY, name: "MagY"},
+	{test: SelfTestMagZ, name: "MagZ"},
+	{test: SelfTestBaro, name: "Baro"},
+	{test: SelfTestGNSS, name: "GNSS"},
+	{test: SelfTestBattery, name: "Battery"},
+	{test: SelfTestFlash, name: "Flash"},
+	{test: SelfTestButton, name: "Button"},
+	{test: SelfTestSync, name: "Sync"},
+}
+
+// Passed returns true if all of the provided tests passed.
+func (s SelfTestResults) Passed(tests SelfTestResults) bool {
+	return s&tests == tests
+}
+
+// PassedNames returns the names of the passed tests.
+func (s SelfTestResults) PassedNames() []string {
+	names := make([]string, 0, len(selfTestNames))
+	for _, t := range selfTestNames {
+		if s.Passed(t.test) {
+			names = append(names, t.name)
+		}
+	}
+	return names
+}
+
+// FailedNames returns the names of the failed tests.
+//
+// Tests of sensors not present on the device, such as the barometer or the GNSS receiver, are reported as failed.
+func (s SelfTestResults) FailedNames() []string {
+	names := make([]string, 0, len(selfTestNames))
+	for _, t := range selfTestNames {
+		if !s.Passed(t.test) {
+			names = append(names, t.name)
+		}
+	}
+	return names
+}
+
+// String returns a string representation of the passed and failed tests.
+func (s SelfTestResults) String() string {
+	return fmt.Sprintf(
+		"passed: %s, failed: %s",
+		strings.Join(s.PassedNames(), "|"),
+		strings.Join(s.FailedNames(), "|"),
+	)
+}
+
+// UnmarshalBinary sets *s from a wire representation of the self test results.
+func (s *SelfTestResults) UnmarshalBinary(data []byte) error {
+	if l := len(data); l != 2 {
+		return fmt.Errorf("unexpected SelfTestResults length: want: %d, got: %d", 2, l)
+	}
+	*s = SelfTestResults(binary.BigEndian.Uint16(data))
+	return nil
+}
+
+type selfTestResultsJSON struct {
+	Raw    uint16
+	Passed []string
+	Failed []string
+}
+
+// MarshalJSON returns a structured JSON representation of the self test results.
+func (s SelfTestResults) MarshalJSON() ([]byte, error) {
+	return json.Marshal(selfTestResultsJSON{
+		Raw:    uint16(s),
+		Passed: s.PassedNames(),
+		Failed: s.FailedNames(),
+	})
+}
+
+// UnmarshalJSON sets *s from the raw value of a structured JSON representation of the self test results.
+func (s *SelfTestResults) UnmarshalJSON(data []byte) error {
+	var js struct {
+		Raw uint16
+	}
+	if err := json.Unmarshal(data, &js); err != nil {
+		return fmt.Errorf("unmarshal self test results: %w", err)
+	}
+	*s = SelfTestResults(js.Raw)
+	return nil
+}
@@ -0,0 +1,31 @@
+package xsens
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSelfTestResults(t *testing.T) {
+	var actual SelfTestResults
+	assert.NilError(t, actual.UnmarshalBinary([]byte{0x77, 0xff}))
+	assert.Assert(t, actual.Passed(SelfTestAccX|SelfTestGyrX|SelfTestMagX|SelfTestBaro))
+	assert.Assert(t, !actual.Passed(SelfTestBattery))
+	assert.DeepEqual(t, []string{"Battery"}, actual.FailedNames())
+	assert.Equal(
+		t,
+		"passed: AccX|AccY|AccZ|GyrX|GyrY|GyrZ|MagX|MagY|MagZ|Baro|GNSS|Flash|Button|Sync, failed: Battery",
+		actual.String(),
+	)
+	assert.ErrorContains(t, actual.UnmarshalBinary([]byte{0x77}), "unexpected SelfTestResults length")
+}
+
+func TestSelfTestResults_JSON(t *testing.T) {
+	expected := SelfTestAccX | SelfTestFlash
+	js, err := json.Marshal(expected)
+	assert.NilError(t, err)
+	var actual SelfTestResults
+	assert.NilError(t, json.Unmarshal(js, &actual))
+	assert.Equal(t, expected, actual)
+}
@@ -1,8 +1,119 @@
 package xsens
 
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.bug.st/serial"
+)
+
 // Default serial communication options.
 const (
 	DefaultSerialBaudRate = 115200
 	DefaultSerialDataBits = 8
 	DefaultSerialStopBits = 1
 )
+
+// baudRateProbeTimeout is the time to wait for a valid message when probing a baud rate.
+const baudRateProbeTimeout = 250 * time.Millisecond
+
+// standardBaudRates are the baud rates probed by DetectBaudRate, most commonly used first.
+var standardBaudRates = []BaudRate{
+	DefaultSerialBaudRate,
+	921600,
+	460800,
+	230400,
+	2000000,
+	1000000,
+	4000000,
+	3686400,
+	57600,
+	38400,
+	19200,
+	9600,
+	76800,
+	28800,
+	14400,
+	4800,
+}
+
+// DetectBaudRate probes the serial port at the standard baud rates and returns the first baud rate at which the
+// Xsens device sends a valid message.
+//
+// Each probe sends a GoToConfig and a ReqDID message, so a detected device is left in config mode. The port is
+// left configured at the detected baud rate, with blocking reads.
+func DetectBaudRate(ctx context.Context, port serial.Port) (_ BaudRate, err error) {
+	defer func() {
+		// the probes leave a short read timeout, restore blocking reads for clients of the port
+		if resetErr := port.SetReadTimeout(serial.NoTimeout); resetErr != nil && err == nil {
+			err = fmt.Errorf("xsens: detect baud rate: %w", resetErr)
+		}
+	}()
+	probe := append(NewMessage(MessageIdentifierGotoConfig, nil), NewMessage(MessageIdentifierReqDID, nil)...)
+	for _, baudRate := range standardBaudRates {
+		if err := ctx.Err(); err != nil {
+			return 0, fmt.Errorf("xsens: detect baud rate: %w", err)
+		}
+		ok, err := probeBaudRate(ctx, port, baudRate, probe)
+		if err != nil {
+			return 0, fmt.Errorf("xsens: detect baud rate: %d: %w", baudRate, err)
+		}
+		if ok {
+			return baudRate, nil
+		}
+	}
+	return 0, fmt.Errorf("xsens: detect baud rate: no valid message at any standard baud rate")
+}
+
+// probeBaudRate configures the port to the baud rate, writes the probe and reports whether a valid message is
+// received before the probe times out.
+func probeBaudRate(ctx context.Context, port serial.Port, baudRate BaudRate, probe []byte) (bool, error) {
+	if err := port.SetMode(&serial.Mode{
+		BaudRate: int(baudRate),
+		DataBits: DefaultSerialDataBits,
+		StopBits: serial.OneStopBit,
+	}); err != nil {
+		return false, err
+	}
+	if err := port.ResetInputBuffer(); err != nil {
+		return false, err
+	}
+	if _, err := port.Write(probe); err != nil {
+		return false, err
+	}
+	deadline := time.Now().Add(baudRateProbeTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	var data []byte
+	buf := make([]byte, 256)
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return false, nil
+		}
+		if err := port.SetReadTimeout(remaining); err != nil {
+			return false, err
+		}
+		n, err := port.Read(buf)
+		if err != nil {
+			return false, err
+		}
+		data = append(data, buf[:n]...)
+		for len(data) > 0 {
+			advance, token, _ := ScanMessages(data, false)
+			if token != nil {
+				if Message(token).Validate() == nil {
+					return true, nil
+				}
+				// noise that looks like a message header: skip the preamble and keep scanning
+				advance -= len(token) - 1
+			}
+			if advance == 0 {
+				break
+			}
+			data = data[advance:]
+		}
+	}
+}
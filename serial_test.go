@@ -0,0 +1,82 @@
+package xsens_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.bug.st/serial"
+	"go.einride.tech/xsens"
+	"go.einride.tech/xsens/mocks/mockserial"
+	"gotest.tools/v3/assert"
+)
+
+func TestDetectBaudRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var baudRate int
+	var written bool
+	port.EXPECT().SetMode(gomock.Any()).AnyTimes().DoAndReturn(func(mode *serial.Mode) error {
+		baudRate = mode.BaudRate
+		return nil
+	})
+	port.EXPECT().ResetInputBuffer().AnyTimes()
+	var readTimeout time.Duration
+	port.EXPECT().SetReadTimeout(gomock.Any()).AnyTimes().DoAndReturn(func(timeout time.Duration) error {
+		readTimeout = timeout
+		return nil
+	})
+	port.EXPECT().Write(gomock.Any()).AnyTimes().DoAndReturn(func(b []byte) (int, error) {
+		written = true
+		return len(b), nil
+	})
+	port.EXPECT().Read(gomock.Any()).AnyTimes().DoAndReturn(func(b []byte) (int, error) {
+		if !written {
+			time.Sleep(time.Millisecond)
+			return 0, nil
+		}
+		written = false
+		if baudRate != 921600 {
+			// garbage with a message header, as received at a mismatching baud rate
+			return copy(b, []byte{0x00, 0xfa, 0xff, 0x30, 0x00, 0x00, 0x12}), nil
+		}
+		return copy(b, xsens.NewMessage(xsens.MessageIdentifierGotoConfigAck, nil)), nil
+	})
+	actual, err := xsens.DetectBaudRate(ctx, port)
+	assert.NilError(t, err)
+	assert.Equal(t, xsens.BaudRate(921600), actual)
+	// the port should be left with blocking reads
+	assert.Equal(t, serial.NoTimeout, readTimeout)
+}
+
+func TestDetectBaudRate_NoResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	port.EXPECT().SetMode(gomock.Any()).AnyTimes()
+	port.EXPECT().ResetInputBuffer().AnyTimes()
+	var readTimeout time.Duration
+	port.EXPECT().SetReadTimeout(gomock.Any()).AnyTimes().DoAndReturn(func(timeout time.Duration) error {
+		readTimeout = timeout
+		return nil
+	})
+	port.EXPECT().Write(gomock.Any()).AnyTimes().DoAndReturn(func(b []byte) (int, error) {
+		return len(b), nil
+	})
+	port.EXPECT().Read(gomock.Any()).AnyTimes().DoAndReturn(func(b []byte) (int, error) {
+		time.Sleep(time.Millisecond)
+		return 0, nil
+	})
+	_, err := xsens.DetectBaudRate(ctx, port)
+	assert.ErrorContains(t, err, "detect baud rate")
+	// the port should be left with blocking reads
+	assert.Equal(t, serial.NoTimeout, readTimeout)
+}
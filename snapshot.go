@@ -0,0 +1,169 @@
+package xsens
+
+import "fmt"
+
+// Snapshot contains the measurement data of a single MTData2 message.
+//
+// Each field is nil when the message did not contain a packet with the corresponding data type. A snapshot does not
+// share memory with the message it was unmarshaled from, and can be copied and sent across goroutines.
+type Snapshot struct {
+	Temperature       *Temperature       `json:",omitempty"`
+	UTCTime           *UTCTime           `json:",omitempty"`
+	PacketCounter     *PacketCounter     `json:",omitempty"`
+	ITOW              *ITOW              `json:",omitempty"`
+	GPSAge            *GPSAge            `json:",omitempty"`
+	PressureAge       *PressureAge       `json:",omitempty"`
+	SampleTimeFine    *SampleTimeFine    `json:",omitempty"`
+	SampleTimeCoarse  *SampleTimeCoarse  `json:",omitempty"`
+	Quaternion        *Quaternion        `json:",omitempty"`
+	RotationMatrix    *RotationMatrix    `json:",omitempty"`
+	EulerAngles       *EulerAngles       `json:",omitempty"`
+	BaroPressure      *BaroPressure      `json:",omitempty"`
+	DeltaV            *DeltaV            `json:",omitempty"`
+	Acceleration      *Acceleration      `json:",omitempty"`
+	FreeAcceleration  *FreeAcceleration  `json:",omitempty"`
+	AccelerationHR    *AccelerationHR    `json:",omitempty"`
+	AltitudeEllipsoid *AltitudeEllipsoid `json:",omitempty"`
+	PositionECEF      *PositionECEF      `json:",omitempty"`
+	LatLon            *LatLon            `json:",omitempty"`
+	GNSSPVTData       *GNSSPVTData       `json:",omitempty"`
+	GNSSSatInfo       *GNSSSatInfo       `json:",omitempty"`
+	RateOfTurn        *RateOfTurn        `json:",omitempty"`
+	DeltaQ            *DeltaQ            `json:",omitempty"`
+	RateOfTurnHR      *RateOfTurnHR      `json:",omitempty"`
+	GPSDOP            *GPSDOP            `json:",omitempty"`
+	GPSSOL            *GPSSOL            `json:",omitempty"`
+	GPSTimeUTC        *GPSTimeUTC        `json:",omitempty"`
+	GPSSVInfo         *GPSSVInfo         `json:",omitempty"`
+	MagneticField     *MagneticField     `json:",omitempty"`
+	VelocityXYZ       *VelocityXYZ       `json:",omitempty"`
+	StatusByte        *StatusByte        `json:",omitempty"`
+	StatusWord        *StatusWord        `json:",omitempty"`
+
+	// Raw contains the packets of the message with unsupported data types.
+	Raw []RawMeasurement `json:",omitempty"`
+}
+
+// Has returns true if the snapshot contains measurement data of the provided data type.
+func (s *Snapshot) Has(dataType DataType) bool {
+	return s.MeasurementData(dataType) != nil
+}
+
+// MeasurementData returns the measurement data of the provided data type, or nil if the snapshot does not contain
+// measurement data of the data type.
+//
+// Measurement data of data types without a field in the snapshot is returned as a *RawMeasurement.
+func (s *Snapshot) MeasurementData(dataType DataType) MeasurementData {
+	if field, ok := snapshotFields[dataType]; ok {
+		return field.get(s)
+	}
+	for i := range s.Raw {
+		if s.Raw[i].DataIdentifier.DataType == dataType {
+			return &s.Raw[i]
+		}
+	}
+	return nil
+}
+
+// UnmarshalMTData2 sets *s to the measurement data of all packets in the provided MTData2 message.
+//
+// Packets that could not be decoded are skipped, and the first decode error is returned after the remaining packets
+// have been decoded.
+func (s *Snapshot) UnmarshalMTData2(m MTData2) error {
+	*s = Snapshot{}
+	var firstErr error
+	for i := 0; i < len(m); {
+		packet, err := m.PacketAt(i)
+		if err != nil {
+			// the boundaries of the remaining packets are unknown
+			if firstErr == nil {
+				firstErr = fmt.Errorf("unmarshal snapshot: packet at %d: %w", i, err)
+			}
+			break
+		}
+		i += len(packet)
+		previous := *s
+		if err := s.NewMeasurementData(packet.Identifier().DataType).UnmarshalMTData2Packet(packet); err != nil {
+			*s = previous
+			if firstErr == nil {
+				firstErr = fmt.Errorf("unmarshal snapshot: %v: %w", packet.Identifier(), err)
+			}
+		}
+	}
+	return firstErr
+}
+
+// NewMeasurementData sets the field of the provided data type to new measurement data, and returns it.
+//
+// Measurement data of data types without a field in the snapshot is appended to Raw.
+func (s *Snapshot) NewMeasurementData(dataType DataType) MeasurementData {
+	if field, ok := snapshotFields[dataType]; ok {
+		return field.set(s)
+	}
+	s.Raw = append(s.Raw, RawMeasurement{})
+	return &s.Raw[len(s.Raw)-1]
+}
+
+// snapshotField is the measurement data field of a data type in a snapshot.
+type snapshotField struct {
+	// get returns the measurement data of the field, or nil if the field is not set.
+	get func(*Snapshot) MeasurementData
+	// set sets the field to new measurement data, and returns it.
+	set func(*Snapshot) MeasurementData
+}
+
+// newSnapshotField returns the snapshot field at the address returned by field.
+func newSnapshotField[T any, P interface {
+	*T
+	MeasurementData
+}](field func(*Snapshot) *P) snapshotField {
+	return snapshotField{
+		get: func(s *Snapshot) MeasurementData {
+			if p := *field(s); p != nil {
+				return p
+			}
+			return nil
+		},
+		set: func(s *Snapshot) MeasurementData {
+			p := P(new(T))
+			*field(s) = p
+			return p
+		},
+	}
+}
+
+// snapshotFields are the snapshot fields of each data type.
+var snapshotFields = map[DataType]snapshotField{
+	DataTypeTemperature:       newSnapshotField(func(s *Snapshot) **Temperature { return &s.Temperature }),
+	DataTypeUTCTime:           newSnapshotField(func(s *Snapshot) **UTCTime { return &s.UTCTime }),
+	DataTypePacketCounter:     newSnapshotField(func(s *Snapshot) **PacketCounter { return &s.PacketCounter }),
+	DataTypeITOW:              newSnapshotField(func(s *Snapshot) **ITOW { return &s.ITOW }),
+	DataTypeGPSAge:            newSnapshotField(func(s *Snapshot) **GPSAge { return &s.GPSAge }),
+	DataTypePressureAge:       newSnapshotField(func(s *Snapshot) **PressureAge { return &s.PressureAge }),
+	DataTypeSampleTimeFine:    newSnapshotField(func(s *Snapshot) **SampleTimeFine { return &s.SampleTimeFine }),
+	DataTypeSampleTimeCoarse:  newSnapshotField(func(s *Snapshot) **SampleTimeCoarse { return &s.SampleTimeCoarse }),
+	DataTypeQuaternion:        newSnapshotField(func(s *Snapshot) **Quaternion { return &s.Quaternion }),
+	DataTypeRotationMatrix:    newSnapshotField(func(s *Snapshot) **RotationMatrix { return &s.RotationMatrix }),
+	DataTypeEulerAngles:       newSnapshotField(func(s *Snapshot) **EulerAngles { return &s.EulerAngles }),
+	DataTypeBaroPressure:      newSnapshotField(func(s *Snapshot) **BaroPressure { return &s.BaroPressure }),
+	DataTypeDeltaV:            newSnapshotField(func(s *Snapshot) **DeltaV { return &s.DeltaV }),
+	DataTypeAcceleration:      newSnapshotField(func(s *Snapshot) **Acceleration { return &s.Acceleration }),
+	DataTypeFreeAcceleration:  newSnapshotField(func(s *Snapshot) **FreeAcceleration { return &s.FreeAcceleration }),
+	DataTypeAccelerationHR:    newSnapshotField(func(s *Snapshot) **AccelerationHR { return &s.AccelerationHR }),
+	DataTypeAltitudeEllipsoid: newSnapshotField(func(s *Snapshot) **AltitudeEllipsoid { return &s.AltitudeEllipsoid }),
+	DataTypePositionECEF:      newSnapshotField(func(s *Snapshot) **PositionECEF { return &s.PositionECEF }),
+	DataTypeLatLon:            newSnapshotField(func(s *Snapshot) **LatLon { return &s.LatLon }),
+	DataTypeGNSSPVTData:       newSnapshotField(func(s *Snapshot) **GNSSPVTData { return &s.GNSSPVTData }),
+	DataTypeGNSSSatInfo:       newSnapshotField(func(s *Snapshot) **GNSSSatInfo { return &s.GNSSSatInfo }),
+	DataTypeRateOfTurn:        newSnapshotField(func(s *Snapshot) **RateOfTurn { return &s.RateOfTurn }),
+	DataTypeDeltaQ:            newSnapshotField(func(s *Snapshot) **DeltaQ { return &s.DeltaQ }),
+	DataTypeRateOfTurnHR:      newSnapshotField(func(s *Snapshot) **RateOfTurnHR { return &s.RateOfTurnHR }),
+	DataTypeGPSDOP:            newSnapshotField(func(s *Snapshot) **GPSDOP { return &s.GPSDOP }),
+	DataTypeGPSSOL:            newSnapshotField(func(s *Snapshot) **GPSSOL { return &s.GPSSOL }),
+	DataTypeGPSTimeUTC:        newSnapshotField(func(s *Snapshot) **GPSTimeUTC { return &s.GPSTimeUTC }),
+	DataTypeGPSSVInfo:         newSnapshotField(func(s *Snapshot) **GPSSVInfo { return &s.GPSSVInfo }),
+	DataTypeMagneticField:     newSnapshotField(func(s *Snapshot) **MagneticField { return &s.MagneticField }),
+	DataTypeVelocityXYZ:       newSnapshotField(func(s *Snapshot) **VelocityXYZ { return &s.VelocityXYZ }),
+	DataTypeStatusByte:        newSnapshotField(func(s *Snapshot) **StatusByte { return &s.StatusByte }),
+	DataTypeStatusWord:        newSnapshotField(func(s *Snapshot) **StatusWord { return &s.StatusWord }),
+}
@@ -0,0 +1,117 @@
+package xsens
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSnapshot_UnmarshalMTData2(t *testing.T) {
+	packetCounter := PacketCounter(1)
+	statusWord := StatusWord(0x01800007)
+	eulerAngles := EulerAngles{X: 1, Y: 2, Z: 3}
+	gnssSatInfo := GNSSSatInfo{
+		ITOW:       4,
+		NumSVS:     1,
+		Satellites: []GNSSSat{{GNSSID: GNSSIDGalileo, SVID: 5, CNO: 6, Flags: 7}},
+	}
+	raw := RawMeasurement{DataIdentifier: DataIdentifier{DataType: DataType(0xf010)}, Data: []byte{8, 9}}
+	var m MTData2
+	for _, tt := range []struct {
+		data MeasurementData
+		id   DataIdentifier
+	}{
+		{data: &packetCounter, id: DataIdentifier{DataType: DataTypePacketCounter}},
+		{data: &statusWord, id: DataIdentifier{DataType: DataTypeStatusWord}},
+		{data: &eulerAngles, id: DataIdentifier{DataType: DataTypeEulerAngles, Precision: PrecisionFloat64}},
+		{data: &gnssSatInfo, id: DataIdentifier{DataType: DataTypeGNSSSatInfo}},
+		{data: &raw, id: raw.DataIdentifier},
+	} {
+		packet, err := tt.data.MarshalMTData2Packet(tt.id)
+		assert.NilError(t, err)
+		m = append(m, packet...)
+	}
+	var s Snapshot
+	assert.NilError(t, s.UnmarshalMTData2(m))
+	assert.DeepEqual(t, Snapshot{
+		PacketCounter: &packetCounter,
+		StatusWord:    &statusWord,
+		EulerAngles:   &eulerAngles,
+		GNSSSatInfo:   &gnssSatInfo,
+		Raw:           []RawMeasurement{raw},
+	}, s)
+	assert.Assert(t, s.Has(DataTypePacketCounter))
+	assert.Assert(t, s.Has(DataType(0xf010)))
+	assert.Assert(t, !s.Has(DataTypeQuaternion))
+	// the snapshot should not share memory with the message
+	for i := range m {
+		m[i] = 0
+	}
+	assert.DeepEqual(t, []byte{8, 9}, s.Raw[0].Data)
+	assert.Equal(t, GNSSIDGalileo, s.GNSSSatInfo.Satellites[0].GNSSID)
+	// the snapshot should be possible to log as JSON
+	data, err := json.Marshal(&s)
+	assert.NilError(t, err)
+	var actual Snapshot
+	assert.NilError(t, json.Unmarshal(data, &actual))
+	assert.DeepEqual(t, s, actual)
+}
+
+func TestSnapshot_UnmarshalMTData2_Error(t *testing.T) {
+	var s Snapshot
+	assert.ErrorContains(t, s.UnmarshalMTData2(MTData2{0x10, 0x20, 0x02, 0x00}), "insufficient data")
+}
+
+func TestSnapshot_UnmarshalMTData2_CorruptPacket(t *testing.T) {
+	packetCounter := PacketCounter(42)
+	packetCounterPacket, err := packetCounter.MarshalMTData2Packet(DataIdentifier{DataType: DataTypePacketCounter})
+	assert.NilError(t, err)
+	// a GNSSSatInfo packet claiming a satellite, without satellite data
+	corruptPacket := NewMTData2Package(8, DataIdentifier{DataType: DataTypeGNSSSatInfo})
+	corruptPacket.Data()[4] = 1
+	statusWord := StatusWord(7)
+	statusWordPacket, err := statusWord.MarshalMTData2Packet(DataIdentifier{DataType: DataTypeStatusWord})
+	assert.NilError(t, err)
+	var data MTData2
+	data = append(data, packetCounterPacket...)
+	data = append(data, corruptPacket...)
+	data = append(data, statusWordPacket...)
+	var s Snapshot
+	assert.ErrorContains(t, s.UnmarshalMTData2(data), "GNSSSatInfo")
+	assert.DeepEqual(t, Snapshot{PacketCounter: &packetCounter, StatusWord: &statusWord}, s)
+	assert.Assert(t, !s.Has(DataTypeGNSSSatInfo))
+}
+
+func TestSnapshot_NewMeasurementData(t *testing.T) {
+	// every measurement data field of the snapshot should have exactly one data type
+	snapshotType := reflect.TypeOf(Snapshot{})
+	dataTypes := map[string]DataType{}
+	for dataType := range snapshotFields {
+		var s Snapshot
+		data := s.NewMeasurementData(dataType)
+		assert.Equal(t, data, s.MeasurementData(dataType))
+		assert.Assert(t, s.Has(dataType))
+		value := reflect.ValueOf(s)
+		var set []string
+		for i := 0; i < snapshotType.NumField(); i++ {
+			if field := value.Field(i); field.Kind() == reflect.Ptr && !field.IsNil() {
+				set = append(set, snapshotType.Field(i).Name)
+			}
+		}
+		assert.DeepEqual(t, []string{dataType.String()}, set)
+		dataTypes[dataType.String()] = dataType
+	}
+	for i := 0; i < snapshotType.NumField(); i++ {
+		if field := snapshotType.Field(i); field.Type.Kind() == reflect.Ptr {
+			_, ok := dataTypes[field.Name]
+			assert.Assert(t, ok, "no data type for field %s", field.Name)
+		}
+	}
+	// data types without a field should be kept as raw measurements
+	var s Snapshot
+	raw := s.NewMeasurementData(DataType(0xf010))
+	assert.Equal(t, raw, MeasurementData(&s.Raw[0]))
+	assert.Assert(t, s.MeasurementData(DataTypeQuaternion) == nil)
+}
@@ -0,0 +1,174 @@
+package xsens
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// status word bit fields.
+const (
+	statusSelftestFlag             = 1 << 0
+	statusFilterValidFlag          = 1 << 1
+	statusGNSSFixFlag              = 1 << 2
+	statusNoRotationUpdateMask     = 0x3 << statusNoRotationUpdateShift
+	statusNoRotationUpdateShift    = 3
+	statusRepresentativeMotionFlag = 1 << 5
+	statusClipFlagsMask            = 0xfff << statusClipFlagsShift
+	statusClipFlagsShift           = 8
+	statusSyncInMarkerFlag         = 1 << 21
+	statusSyncOutMarkerFlag        = 1 << 22
+	statusFilterModeMask           = 0x7 << statusFilterModeShift
+	statusFilterModeShift          = 23
+)
+
+// Selftest returns true if the device passed the self-test.
+func (t *StatusWord) Selftest() bool {
+	return *t&statusSelftestFlag > 0
+}
+
+// FilterValid returns true if input into the orientation filter is reliable and complete.
+func (t *StatusWord) FilterValid() bool {
+	return *t&statusFilterValidFlag > 0
+}
+
+// GNSSFix returns true if the GNSS unit has a proper fix.
+func (t *StatusWord) GNSSFix() bool {
+	return *t&statusGNSSFixFlag > 0
+}
+
+// NoRotationUpdateStatus returns the status of the no rotation update procedure.
+func (t *StatusWord) NoRotationUpdateStatus() NoRotationUpdateStatus {
+	return NoRotationUpdateStatus((*t & statusNoRotationUpdateMask) >> statusNoRotationUpdateShift)
+}
+
+// RepresentativeMotion returns true if the device is in In-run Compass Calibration Representative Mode.
+func (t *StatusWord) RepresentativeMotion() bool {
+	return *t&statusRepresentativeMotionFlag > 0
+}
+
+// ClipFlags returns the clip flags, indicating out of range values on sensors.
+func (t *StatusWord) ClipFlags() ClipFlags {
+	return ClipFlags((*t & statusClipFlagsMask) >> statusClipFlagsShift)
+}
+
+// SyncInMarker returns true if a SyncIn has been detected.
+func (t *StatusWord) SyncInMarker() bool {
+	return *t&statusSyncInMarkerFlag > 0
+}
+
+// SyncOutMarker returns true if SyncOut is active.
+func (t *StatusWord) SyncOutMarker() bool {
+	return *t&statusSyncOutMarkerFlag > 0
+}
+
+// FilterMode returns the filter mode.
+func (t *StatusWord) FilterMode() FilterMode {
+	return FilterMode((*t & statusFilterModeMask) >> statusFilterModeShift)
+}
+
+// statusWordJSON is the JSON representation of a status word.
+type statusWordJSON struct {
+	Raw                    uint32
+	Selftest               bool
+	FilterValid            bool
+	GNSSFix                bool
+	NoRotationUpdateStatus NoRotationUpdateStatus
+	RepresentativeMotion   bool
+	ClipFlags              []string
+	SyncInMarker           bool
+	SyncOutMarker          bool
+	FilterMode             FilterMode
+}
+
+// MarshalJSON returns a structured JSON representation of the status word.
+func (t *StatusWord) MarshalJSON() ([]byte, error) {
+	return json.Marshal(statusWordJSON{
+		Raw:                    uint32(*t),
+		Selftest:               t.Selftest(),
+		FilterValid:            t.FilterValid(),
+		GNSSFix:                t.GNSSFix(),
+		NoRotationUpdateStatus: t.NoRotationUpdateStatus(),
+		RepresentativeMotion:   t.RepresentativeMotion(),
+		ClipFlags:              t.ClipFlags().Names(),
+		SyncInMarker:           t.SyncInMarker(),
+		SyncOutMarker:          t.SyncOutMarker(),
+		FilterMode:             t.FilterMode(),
+	})
+}
+
+// UnmarshalJSON sets *t from the raw value of a structured JSON representation of the status word.
+func (t *StatusWord) UnmarshalJSON(data []byte) error {
+	var js struct {
+		Raw uint32
+	}
+	if err := json.Unmarshal(data, &js); err != nil {
+		return fmt.Errorf("unmarshal status word: %w", err)
+	}
+	*t = StatusWord(js.Raw)
+	return nil
+}
+
+// Selftest returns true if the device passed the self-test.
+func (t *StatusByte) Selftest() bool {
+	return t.statusWord().Selftest()
+}
+
+// FilterValid returns true if input into the orientation filter is reliable and complete.
+func (t *StatusByte) FilterValid() bool {
+	return t.statusWord().FilterValid()
+}
+
+// GNSSFix returns true if the GNSS unit has a proper fix.
+func (t *StatusByte) GNSSFix() bool {
+	return t.statusWord().GNSSFix()
+}
+
+// NoRotationUpdateStatus returns the status of the no rotation update procedure.
+func (t *StatusByte) NoRotationUpdateStatus() NoRotationUpdateStatus {
+	return t.statusWord().NoRotationUpdateStatus()
+}
+
+// RepresentativeMotion returns true if the device is in In-run Compass Calibration Representative Mode.
+func (t *StatusByte) RepresentativeMotion() bool {
+	return t.statusWord().RepresentativeMotion()
+}
+
+// statusWord returns the status word corresponding to the status byte.
+func (t *StatusByte) statusWord() *StatusWord {
+	w := StatusWord(*t)
+	return &w
+}
+
+// statusByteJSON is the JSON representation of a status byte.
+type statusByteJSON struct {
+	Raw                    uint8
+	Selftest               bool
+	FilterValid            bool
+	GNSSFix                bool
+	NoRotationUpdateStatus NoRotationUpdateStatus
+	RepresentativeMotion   bool
+}
+
+// MarshalJSON returns a structured JSON representation of the status byte.
+func (t *StatusByte) MarshalJSON() ([]byte, error) {
+	return json.Marshal(statusByteJSON{
+		Raw:                    uint8(*t),
+		Selftest:               t.Selftest(),
+		FilterValid:            t.FilterValid(),
+		GNSSFix:                t.GNSSFix(),
+		NoRotationUpdateStatus: t.NoRotationUpdateStatus(),
+		RepresentativeMotion:   t.RepresentativeMotion(),
+	})
+}
+
+// UnmarshalJSON sets *t from the raw value of a structured JSON representation of the status byte.
+func (t *StatusByte) UnmarshalJSON(data []byte) error {
+	var js struct {
+		Raw uint8
+	}
+	if err := json.Unmarshal(data, &js); err != nil {
+		return fmt.Errorf("unmarshal status byte: %w", err)
+	}
+	*t = StatusByte(js.Raw)
+	return nil
+}
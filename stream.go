@@ -0,0 +1,354 @@
+package xsens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrStreamActive is the error of a stream started while the client already has an active stream.
+var ErrStreamActive = errors.New("stream already active")
+
+// Stream receives messages from a client in a goroutine and dispatches them to subscriptions.
+//
+// While streaming, the client's request methods, such as GetDeviceID, are safe for concurrent use and receive their
+// acknowledgements from the stream.
+type Stream struct {
+	client        *Client
+	mu            sync.Mutex
+	subscriptions map[*Subscription]struct{}
+	closed        bool
+	pendingMu     sync.Mutex
+	pending       []*pendingRequest
+	invalid       uint64 // accessed atomically
+	done          chan struct{}
+	err           error
+}
+
+// pendingRequest is a request waiting for an acknowledgement from the stream.
+type pendingRequest struct {
+	ack    MessageIdentifier
+	result chan pendingResult
+}
+
+// pendingResult is the result of a pending request.
+type pendingResult struct {
+	message Message
+	err     error
+}
+
+// Stream starts receiving messages in a goroutine, until the context is done or reading from the port fails.
+// Received messages that fail validation are skipped, see Stream.Invalid.
+//
+// The stream takes ownership of the client's receiving, no receiving methods, such as Receive and
+// ScanMeasurementData, may be called on the client while streaming. When the stream has stopped, the client returns
+// to receiving acknowledgements itself.
+//
+// A client has at most one active stream. Starting a stream while another is active returns a stopped stream,
+// with ErrStreamActive as its error.
+func (c *Client) Stream(ctx context.Context) *Stream {
+	s := &Stream{
+		client:        c,
+		subscriptions: make(map[*Subscription]struct{}),
+		done:          make(chan struct{}),
+	}
+	c.mu.Lock()
+	if c.stream != nil {
+		c.mu.Unlock()
+		s.err = fmt.Errorf("xsens client: stream: %w", ErrStreamActive)
+		s.closed = true
+		close(s.done)
+		return s
+	}
+	c.stream = s
+	c.mu.Unlock()
+	go s.run(ctx)
+	return s
+}
+
+// Subscribe to the stream.
+//
+// Subscribing to a stream that has stopped returns a subscription with closed channels.
+func (s *Stream) Subscribe(opts ...SubscriptionOption) *Subscription {
+	options := defaultSubscriptionOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.bufferSize < 1 {
+		options.bufferSize = 1
+	}
+	sub := &Subscription{
+		stream:    s,
+		policy:    options.overflowPolicy,
+		snapshots: make(chan *Snapshot, options.bufferSize),
+		messages:  make(chan Message, options.bufferSize),
+		done:      make(chan struct{}),
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		sub.closeChannels()
+		return sub
+	}
+	s.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+// Done returns a channel that is closed when the stream has stopped.
+func (s *Stream) Done() <-chan struct{} {
+	return s.done
+}
+
+// Invalid returns the number of received messages skipped due to failing validation, such as messages with an invalid
+// checksum.
+func (s *Stream) Invalid() uint64 {
+	return atomic.LoadUint64(&s.invalid)
+}
+
+// Wait for the stream to stop, and return the error that stopped it.
+func (s *Stream) Wait() error {
+	<-s.done
+	return s.err
+}
+
+func (s *Stream) run(ctx context.Context) {
+	defer close(s.done)
+	for {
+		if err := s.client.Receive(ctx); err != nil {
+			if errors.Is(err, ErrInvalidMessage) {
+				// skip corrupt messages, such as on a noisy serial line
+				atomic.AddUint64(&s.invalid, 1)
+				continue
+			}
+			s.stop(err)
+			return
+		}
+		s.resolvePending(s.client.message)
+		if s.client.MessageIdentifier() == MessageIdentifierMTData2 {
+			if snapshot, err := s.client.Snapshot(); err == nil {
+				s.dispatchSnapshot(ctx, snapshot)
+				continue
+			}
+		}
+		// MTData2 messages that can't be decoded are dispatched as messages
+		s.dispatchMessage(ctx, append(Message(nil), s.client.message...))
+	}
+}
+
+func (s *Stream) stop(err error) {
+	s.client.mu.Lock()
+	if s.client.stream == s {
+		s.client.stream = nil
+	}
+	s.client.mu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+	s.closed = true
+	for sub := range s.subscriptions {
+		sub.closeChannels()
+		delete(s.subscriptions, sub)
+	}
+}
+
+// request sends a message and waits for the stream to receive the provided acknowledgement.
+func (s *Stream) request(ctx context.Context, message Message, ack MessageIdentifier) (Message, error) {
+	p := &pendingRequest{ack: ack, result: make(chan pendingResult, 1)}
+	s.pendingMu.Lock()
+	s.pending = append(s.pending, p)
+	s.pendingMu.Unlock()
+	defer s.removePending(p)
+	if err := s.client.send(ctx, message); err != nil {
+		return nil, err
+	}
+	select {
+	case result := <-p.result:
+		if result.err != nil {
+			return nil, fmt.Errorf("receive until %v: %w", ack, result.err)
+		}
+		return result.message, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("receive until %v: %w", ack, ctx.Err())
+	case <-s.done:
+		return nil, fmt.Errorf("receive until %v: stream stopped: %w", ack, s.err)
+	}
+}
+
+// resolvePending resolves the oldest pending request waiting for the message.
+//
+// Error messages resolve the oldest pending request.
+func (s *Stream) resolvePending(message Message) {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+	for i, p := range s.pending {
+		if message.IsError() {
+			p.result <- pendingResult{err: &DeviceError{ErrorCode: message.ErrorCode()}}
+		} else if message.Identifier() == p.ack {
+			p.result <- pendingResult{message: append(Message(nil), message...)}
+		} else {
+			continue
+		}
+		s.pending = append(s.pending[:i], s.pending[i+1:]...)
+		return
+	}
+}
+
+// removePending removes a pending request.
+func (s *Stream) removePending(p *pendingRequest) {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+	for i, q := range s.pending {
+		if q == p {
+			s.pending = append(s.pending[:i], s.pending[i+1:]...)
+			return
+		}
+	}
+}
+
+func (s *Stream) dispatchSnapshot(ctx context.Context, snapshot *Snapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subscriptions {
+		sub.sendSnapshot(ctx, snapshot)
+	}
+}
+
+func (s *Stream) dispatchMessage(ctx context.Context, message Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subscriptions {
+		sub.sendMessage(ctx, message)
+	}
+}
+
+// Subscription to a stream.
+type Subscription struct {
+	dropped   uint64 // accessed atomically
+	stream    *Stream
+	policy    OverflowPolicy
+	snapshots chan *Snapshot
+	messages  chan Message
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// Snapshots returns a channel of snapshots, one for each received MTData2 message.
+//
+// The channel is closed when the subscription is closed or the stream has stopped.
+func (s *Subscription) Snapshots() <-chan *Snapshot {
+	return s.snapshots
+}
+
+// Messages returns a channel of received messages that are not measurement data.
+//
+// The channel is closed when the subscription is closed or the stream has stopped.
+func (s *Subscription) Messages() <-chan Message {
+	return s.messages
+}
+
+// Dropped returns the number of snapshots and messages dropped due to the subscription's buffers being full.
+func (s *Subscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Close the subscription.
+func (s *Subscription) Close() {
+	s.closeOnce.Do(func() {
+		// unblock any pending send, before waiting for the stream to release its lock
+		close(s.done)
+		s.stream.mu.Lock()
+		defer s.stream.mu.Unlock()
+		if _, ok := s.stream.subscriptions[s]; ok {
+			delete(s.stream.subscriptions, s)
+			s.closeChannels()
+		}
+	})
+}
+
+// closeChannels closes the subscription's channels, the caller must hold the stream's lock.
+func (s *Subscription) closeChannels() {
+	close(s.snapshots)
+	close(s.messages)
+}
+
+func (s *Subscription) sendSnapshot(ctx context.Context, snapshot *Snapshot) {
+	switch s.policy {
+	case OverflowPolicyBlock:
+		select {
+		case s.snapshots <- snapshot:
+		case <-s.done:
+		case <-ctx.Done():
+		}
+	case OverflowPolicyDropOldest:
+		for {
+			select {
+			case s.snapshots <- snapshot:
+				return
+			default:
+			}
+			select {
+			case <-s.snapshots:
+				atomic.AddUint64(&s.dropped, 1)
+			default:
+			}
+		}
+	}
+}
+
+func (s *Subscription) sendMessage(ctx context.Context, message Message) {
+	switch s.policy {
+	case OverflowPolicyBlock:
+		select {
+		case s.messages <- message:
+		case <-s.done:
+		case <-ctx.Done():
+		}
+	case OverflowPolicyDropOldest:
+		for {
+			select {
+			case s.messages <- message:
+				return
+			default:
+			}
+			select {
+			case <-s.messages:
+				atomic.AddUint64(&s.dropped, 1)
+			default:
+			}
+		}
+	}
+}
+
+type subscriptionOptions struct {
+	// bufferSize is the size of the subscription's channels.
+	bufferSize int
+	// overflowPolicy determines how the subscription handles full channels.
+	overflowPolicy OverflowPolicy
+}
+
+// defaultSubscriptionOptions returns subscriptionOptions with sensible default values.
+func defaultSubscriptionOptions() *subscriptionOptions {
+	return &subscriptionOptions{
+		bufferSize:     16,
+		overflowPolicy: OverflowPolicyDropOldest,
+	}
+}
+
+// SubscriptionOption configures a Subscription.
+type SubscriptionOption func(*subscriptionOptions)
+
+// WithBufferSize configures the size of the subscription's channels, with a minimum size of 1.
+func WithBufferSize(size int) SubscriptionOption {
+	return func(opt *subscriptionOptions) {
+		opt.bufferSize = size
+	}
+}
+
+// WithOverflowPolicy configures how the subscription handles data when its channels are full.
+func WithOverflowPolicy(policy OverflowPolicy) SubscriptionOption {
+	return func(opt *subscriptionOptions) {
+		opt.overflowPolicy = policy
+	}
+}
@@ -0,0 +1,244 @@
+package xsens_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.einride.tech/xsens"
+	"go.einride.tech/xsens/mocks/mockserial"
+	"gotest.tools/v3/assert"
+)
+
+func newMTData2Message(t *testing.T, packetCounter xsens.PacketCounter) xsens.Message {
+	t.Helper()
+	packet, err := packetCounter.MarshalMTData2Packet(xsens.DataIdentifier{DataType: xsens.DataTypePacketCounter})
+	assert.NilError(t, err)
+	return xsens.NewMessage(xsens.MessageIdentifierMTData2, packet)
+}
+
+func TestStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	r, w := io.Pipe()
+	port.EXPECT().Read(gomock.Any()).AnyTimes().DoAndReturn(r.Read)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	stream := client.Stream(ctx)
+	blocking := stream.Subscribe(xsens.WithOverflowPolicy(xsens.OverflowPolicyBlock), xsens.WithBufferSize(1))
+	dropping := stream.Subscribe(xsens.WithBufferSize(1))
+	closed := stream.Subscribe()
+	closed.Close()
+	_, ok := <-closed.Snapshots()
+	assert.Assert(t, !ok)
+
+	// the blocking subscription should receive every snapshot
+	for i := 1; i <= 3; i++ {
+		_, err := w.Write(newMTData2Message(t, xsens.PacketCounter(i)))
+		assert.NilError(t, err)
+		snapshot := <-blocking.Snapshots()
+		assert.Equal(t, xsens.PacketCounter(i), *snapshot.PacketCounter)
+	}
+	// non-measurement messages should be dispatched as messages
+	wakeUp := xsens.NewMessage(xsens.MessageIdentifierWakeup, nil)
+	_, err := w.Write(wakeUp)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, wakeUp, <-blocking.Messages())
+
+	// the dropping subscription should only have kept the latest snapshot
+	snapshot := <-dropping.Snapshots()
+	assert.Equal(t, xsens.PacketCounter(3), *snapshot.PacketCounter)
+	assert.Equal(t, uint64(2), dropping.Dropped())
+	assert.DeepEqual(t, wakeUp, <-dropping.Messages())
+
+	// the stream should stop with the port's error
+	assert.NilError(t, w.CloseWithError(io.ErrUnexpectedEOF))
+	assert.Assert(t, errors.Is(stream.Wait(), io.ErrUnexpectedEOF))
+	_, ok = <-blocking.Snapshots()
+	assert.Assert(t, !ok)
+	_, ok = <-dropping.Messages()
+	assert.Assert(t, !ok)
+	_, ok = <-stream.Subscribe().Snapshots()
+	assert.Assert(t, !ok)
+}
+
+func TestStream_ContextDone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	r, w := io.Pipe()
+	defer func() {
+		assert.NilError(t, w.Close())
+	}()
+	port.EXPECT().Read(gomock.Any()).AnyTimes().DoAndReturn(r.Read)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	stream := client.Stream(ctx)
+	sub := stream.Subscribe(xsens.WithOverflowPolicy(xsens.OverflowPolicyBlock))
+	cancel()
+	assert.Assert(t, errors.Is(stream.Wait(), context.Canceled))
+	_, ok := <-sub.Snapshots()
+	assert.Assert(t, !ok)
+}
+
+func TestStream_InvalidMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	r, w := io.Pipe()
+	defer func() {
+		assert.NilError(t, w.Close())
+	}()
+	port.EXPECT().Read(gomock.Any()).AnyTimes().DoAndReturn(r.Read)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	stream := client.Stream(ctx)
+	sub := stream.Subscribe(xsens.WithOverflowPolicy(xsens.OverflowPolicyBlock))
+	// a message with an invalid checksum, as received on a noisy serial line
+	corrupt := newMTData2Message(t, 2)
+	corrupt[len(corrupt)-1]++
+	go func() {
+		_, _ = w.Write(newMTData2Message(t, 1))
+		_, _ = w.Write(corrupt)
+		_, _ = w.Write(newMTData2Message(t, 3))
+	}()
+	// the stream should skip the corrupt message and keep receiving
+	first := <-sub.Snapshots()
+	assert.Equal(t, xsens.PacketCounter(1), *first.PacketCounter)
+	second := <-sub.Snapshots()
+	assert.Equal(t, xsens.PacketCounter(3), *second.PacketCounter)
+	assert.Equal(t, uint64(1), stream.Invalid())
+	cancel()
+	assert.Assert(t, errors.Is(stream.Wait(), context.Canceled))
+}
+
+func TestStream_Request(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	r, w := io.Pipe()
+	defer func() {
+		assert.NilError(t, w.Close())
+	}()
+	port.EXPECT().Read(gomock.Any()).AnyTimes().DoAndReturn(r.Read)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	stream := client.Stream(ctx)
+	sub := stream.Subscribe(xsens.WithOverflowPolicy(xsens.OverflowPolicyBlock))
+	// when the device receives a request, it should reply after sending measurement data
+	port.EXPECT().
+		Write(gomock.Any()).
+		Times(2).
+		DoAndReturn(func(b []byte) (int, error) {
+			var reply xsens.Message
+			switch xsens.Message(b).Identifier() {
+			case xsens.MessageIdentifierReqDID:
+				reply = xsens.NewMessage(xsens.MessageIdentifierDeviceID, []byte{0x01, 0x02, 0x03, 0x04})
+			default:
+				reply = xsens.NewMessage(xsens.MessageIdentifierError, []byte{uint8(xsens.ErrorCodeInvalidMessage)})
+			}
+			go func() {
+				_, _ = w.Write(newMTData2Message(t, 1))
+				_, _ = w.Write(reply)
+			}()
+			return len(b), nil
+		})
+
+	// requests should receive their acknowledgement from the stream
+	deviceID, err := client.GetDeviceID(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, xsens.DeviceID(0x01020304), *deviceID)
+	_, err = client.GetProductCode(ctx)
+	var deviceError *xsens.DeviceError
+	assert.Assert(t, errors.As(err, &deviceError), err)
+	assert.Equal(t, xsens.ErrorCodeInvalidMessage, deviceError.ErrorCode)
+
+	// and measurement data should not be lost
+	for i := 0; i < 2; i++ {
+		snapshot := <-sub.Snapshots()
+		assert.Equal(t, xsens.PacketCounter(1), *snapshot.PacketCounter)
+	}
+	cancel()
+	assert.Assert(t, errors.Is(stream.Wait(), context.Canceled))
+}
+
+func TestStream_Active(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	r, w := io.Pipe()
+	defer func() {
+		assert.NilError(t, w.Close())
+	}()
+	port.EXPECT().Read(gomock.Any()).AnyTimes().DoAndReturn(r.Read)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	first := client.Stream(ctx)
+	sub := first.Subscribe(xsens.WithOverflowPolicy(xsens.OverflowPolicyBlock))
+
+	// a second stream should be rejected while the first is active
+	second := client.Stream(ctx)
+	assert.Assert(t, errors.Is(second.Wait(), xsens.ErrStreamActive))
+	_, ok := <-second.Subscribe().Snapshots()
+	assert.Assert(t, !ok)
+
+	// and the first stream should be unaffected
+	go func() {
+		_, _ = w.Write(newMTData2Message(t, 1))
+	}()
+	snapshot := <-sub.Snapshots()
+	assert.Equal(t, xsens.PacketCounter(1), *snapshot.PacketCounter)
+}
+
+func TestStream_Restart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	port := mockserial.NewMockPort(ctrl)
+	r, w := io.Pipe()
+	defer func() {
+		assert.NilError(t, w.Close())
+	}()
+	port.EXPECT().Read(gomock.Any()).AnyTimes().DoAndReturn(r.Read)
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// when a first stream has stopped
+	firstCtx, firstCancel := context.WithCancel(ctx)
+	first := client.Stream(firstCtx)
+	firstCancel()
+	assert.Assert(t, errors.Is(first.Wait(), context.Canceled))
+
+	// a new stream should be started
+	second := client.Stream(ctx)
+	sub := second.Subscribe(xsens.WithOverflowPolicy(xsens.OverflowPolicyBlock))
+
+	// and requests should receive their acknowledgement from the new stream
+	port.EXPECT().
+		Write(gomock.Any()).
+		DoAndReturn(func(b []byte) (int, error) {
+			go func() {
+				_, _ = w.Write(newMTData2Message(t, 1))
+				_, _ = w.Write(xsens.NewMessage(xsens.MessageIdentifierDeviceID, []byte{0x01, 0x02, 0x03, 0x04}))
+			}()
+			return len(b), nil
+		})
+	deviceID, err := client.GetDeviceID(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, xsens.DeviceID(0x01020304), *deviceID)
+	snapshot := <-sub.Snapshots()
+	assert.Equal(t, xsens.PacketCounter(1), *snapshot.PacketCounter)
+}
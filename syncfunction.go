@@ -0,0 +1,23 @@
+package xsens
+
+// SyncFunction represents the function of a sync line.
+type SyncFunction uint8
+
+//go:generate stringer -type SyncFunction -trimprefix SyncFunction
+
+const (
+	// SyncFunctionTriggerIndication marks the next output message when a pulse is received on an input line.
+	SyncFunctionTriggerIndication SyncFunction = 0x03
+
+	// SyncFunctionIntervalTransitionMeasurement generates a pulse on an output line at each sample.
+	SyncFunctionIntervalTransitionMeasurement SyncFunction = 0x04
+
+	// SyncFunctionSendLatest sends the latest available output when a pulse is received on an input line.
+	SyncFunctionSendLatest SyncFunction = 0x08
+
+	// SyncFunctionClockBiasEstimation estimates the device's clock bias from the pulses on an input line.
+	SyncFunctionClockBiasEstimation SyncFunction = 0x09
+
+	// SyncFunctionStartSampling starts sampling when a pulse is received on an input line.
+	SyncFunctionStartSampling SyncFunction = 0x0B
+)
@@ -0,0 +1,13 @@
+package xsens
+
+// SyncLine identifies a sync line of an Xsens device.
+//
+// The available lines depend on the device, see the device's documentation for lines other than the listed ones.
+type SyncLine uint8
+
+//go:generate stringer -type SyncLine -trimprefix SyncLine
+
+const (
+	SyncLineIn1 SyncLine = 0x00
+	SyncLineIn2 SyncLine = 0x01
+)
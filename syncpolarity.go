@@ -0,0 +1,12 @@
+package xsens
+
+// SyncPolarity represents the edges a sync line triggers on, or the polarity of a generated pulse.
+type SyncPolarity uint8
+
+//go:generate stringer -type SyncPolarity -trimprefix SyncPolarity
+
+const (
+	SyncPolarityRisingEdge  SyncPolarity = 0x01
+	SyncPolarityFallingEdge SyncPolarity = 0x02
+	SyncPolarityBothEdges   SyncPolarity = 0x03
+)
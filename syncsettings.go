@@ -0,0 +1,92 @@
+package xsens
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// SyncSetting is the configuration of a single sync line function.
+type SyncSetting struct {
+	// Function is the function of the sync line.
+	Function SyncFunction
+	// Line is the sync line the function applies to.
+	Line SyncLine
+	// Polarity is the edge an input line triggers on, or the polarity of a pulse generated on an output line.
+	Polarity SyncPolarity
+	// TriggerOnce specifies whether the function is only triggered by the first pulse.
+	TriggerOnce bool
+	// SkipFirst is the number of initial pulses or samples to skip.
+	SkipFirst uint16
+	// SkipFactor is the number of pulses or samples to skip after each triggering one.
+	SkipFactor uint16
+	// PulseWidth is the width of a pulse generated on an output line, in the device's time resolution.
+	PulseWidth uint16
+	// Offset is the delay between an event and the triggered action, in the device's time resolution.
+	Offset int16
+}
+
+const syncSettingLength = 12
+
+// MarshalBinary returns the wire representation of the sync setting.
+func (s *SyncSetting) MarshalBinary() ([]byte, error) {
+	result := make([]byte, syncSettingLength)
+	result[0] = uint8(s.Function)
+	result[1] = uint8(s.Line)
+	result[2] = uint8(s.Polarity)
+	if s.TriggerOnce {
+		result[3] = 1
+	}
+	binary.BigEndian.PutUint16(result[4:6], s.SkipFirst)
+	binary.BigEndian.PutUint16(result[6:8], s.SkipFactor)
+	binary.BigEndian.PutUint16(result[8:10], s.PulseWidth)
+	binary.BigEndian.PutUint16(result[10:12], uint16(s.Offset))
+	return result, nil
+}
+
+// UnmarshalBinary sets *s from a wire representation of the sync setting.
+func (s *SyncSetting) UnmarshalBinary(data []byte) error {
+	if l := len(data); l != syncSettingLength {
+		return fmt.Errorf("unexpected SyncSetting length: want: %d, got: %d", syncSettingLength, l)
+	}
+	s.Function = SyncFunction(data[0])
+	s.Line = SyncLine(data[1])
+	s.Polarity = SyncPolarity(data[2])
+	s.TriggerOnce = data[3] != 0
+	s.SkipFirst = binary.BigEndian.Uint16(data[4:6])
+	s.SkipFactor = binary.BigEndian.Uint16(data[6:8])
+	s.PulseWidth = binary.BigEndian.Uint16(data[8:10])
+	s.Offset = int16(binary.BigEndian.Uint16(data[10:12]))
+	return nil
+}
+
+// SyncSettings is the sync configuration of an Xsens device, one setting per configured sync line function.
+type SyncSettings []SyncSetting
+
+// MarshalBinary returns the wire representation of the sync settings.
+func (s SyncSettings) MarshalBinary() ([]byte, error) {
+	result := make([]byte, 0, len(s)*syncSettingLength)
+	for i := range s {
+		data, err := s[i].MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, data...)
+	}
+	return result, nil
+}
+
+// UnmarshalBinary sets *s from a wire representation of the sync settings.
+func (s *SyncSettings) UnmarshalBinary(data []byte) error {
+	if len(data)%syncSettingLength != 0 {
+		return fmt.Errorf("unexpected SyncSettings length: %d is not a multiple of %d", len(data), syncSettingLength)
+	}
+	*s = (*s)[:0]
+	for i := 0; i < len(data); i += syncSettingLength {
+		var setting SyncSetting
+		if err := setting.UnmarshalBinary(data[i : i+syncSettingLength]); err != nil {
+			return err
+		}
+		*s = append(*s, setting)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package xsens
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSyncSettings_MarshalBinary(t *testing.T) {
+	expected := SyncSettings{
+		{
+			Function: SyncFunctionTriggerIndication,
+			Line:     SyncLineIn1,
+			Polarity: SyncPolarityRisingEdge,
+		},
+		{
+			Function:    SyncFunctionSendLatest,
+			Line:        SyncLineIn2,
+			Polarity:    SyncPolarityBothEdges,
+			TriggerOnce: true,
+			SkipFirst:   10,
+			SkipFactor:  3,
+			PulseWidth:  1000,
+			Offset:      -250,
+		},
+	}
+	data, err := expected.MarshalBinary()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []byte{
+		0x03, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x08, 0x01, 0x03, 0x01, 0x00, 0x0a, 0x00, 0x03, 0x03, 0xe8, 0xff, 0x06,
+	}, data)
+	var actual SyncSettings
+	assert.NilError(t, actual.UnmarshalBinary(data))
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestSyncSettings_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var actual SyncSettings
+	assert.ErrorContains(t, actual.UnmarshalBinary(make([]byte, 13)), "unexpected SyncSettings length")
+}
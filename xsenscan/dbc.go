@@ -0,0 +1,171 @@
+package xsenscan
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"go.einride.tech/xsens"
+)
+
+const (
+	// dbcNode is the name of the transmitting node in generated DBC files.
+	dbcNode = "Xsens"
+	// dbcNoReceiver is the DBC placeholder for signals without a known receiver.
+	dbcNoReceiver = "Vector__XXX"
+	// dbcExtendedIDFlag is set on the CAN IDs of messages with 29 bit IDs in DBC files.
+	dbcExtendedIDFlag = 0x80000000
+)
+
+// dbcMessage is a message of a generated DBC file.
+type dbcMessage struct {
+	id         uint32
+	extended   bool
+	frequency  xsens.OutputFrequency
+	definition MessageDefinition
+}
+
+// WriteDBC writes a DBC file defining the CAN messages of the provided CAN output configuration.
+//
+// Settings without an ID mask use the default CAN ID of their data identifier, as for NewDecoder. An empty
+// configuration defines the messages of all supported data identifiers at their default CAN IDs.
+// The output frequency of each setting is written as the cycle time of its message.
+func WriteDBC(w io.Writer, configuration xsens.CANOutputConfiguration) error {
+	messages, err := dbcMessages(configuration)
+	if err != nil {
+		return fmt.Errorf("xsens can: write DBC: %w", err)
+	}
+	b := bufio.NewWriter(w)
+	writeDBCHeader(b)
+	for _, message := range messages {
+		writeDBCMessage(b, message)
+	}
+	writeDBCAttributes(b, messages)
+	if err := b.Flush(); err != nil {
+		return fmt.Errorf("xsens can: write DBC: %w", err)
+	}
+	return nil
+}
+
+func dbcMessages(configuration xsens.CANOutputConfiguration) ([]dbcMessage, error) {
+	if len(configuration) == 0 {
+		messages := make([]dbcMessage, 0, len(messageDefinitions))
+		for _, definition := range messageDefinitions {
+			messages = append(messages, dbcMessage{id: uint32(definition.DataIdentifier), definition: definition})
+		}
+		return messages, nil
+	}
+	messages := make([]dbcMessage, 0, len(configuration))
+	ids := make(map[uint32]xsens.CANDataIdentifier, len(configuration))
+	for i := range configuration {
+		setting := &configuration[i]
+		definition, ok := LookupMessageDefinition(setting.CANDataIdentifier)
+		if !ok {
+			return nil, fmt.Errorf("unsupported data identifier: %v", setting.CANDataIdentifier)
+		}
+		id := setting.IDMask
+		if id == 0 {
+			id = setting.DefaultIDMask()
+		}
+		if other, ok := ids[id]; ok {
+			return nil, fmt.Errorf("%v and %v have the same CAN ID: 0x%x", other, setting.CANDataIdentifier, id)
+		}
+		ids[id] = setting.CANDataIdentifier
+		messages = append(messages, dbcMessage{
+			id:         id,
+			extended:   setting.CANIDLengthFlag == xsens.CANIDLengthFlag29bits,
+			frequency:  setting.OutputFrequency,
+			definition: definition,
+		})
+	}
+	return messages, nil
+}
+
+func writeDBCHeader(w *bufio.Writer) {
+	_, _ = w.WriteString("VERSION \"\"\n\n")
+	_, _ = w.WriteString("NS_ :\n\tBA_\n\tBA_DEF_\n\tBA_DEF_DEF_\n\tCM_\n\n")
+	_, _ = w.WriteString("BS_:\n\n")
+	_, _ = fmt.Fprintf(w, "BU_: %s\n", dbcNode)
+}
+
+func writeDBCMessage(w *bufio.Writer, message dbcMessage) {
+	_, _ = fmt.Fprintf(
+		w,
+		"\nBO_ %d %v: %d %s\n",
+		message.dbcID(),
+		message.definition.DataIdentifier,
+		message.definition.Length,
+		dbcNode,
+	)
+	for i := range message.definition.Signals {
+		signal := &message.definition.Signals[i]
+		sign := '+'
+		if signal.Signed {
+			sign = '-'
+		}
+		// signals are big-endian (Motorola), with the start bit being the most significant bit
+		_, _ = fmt.Fprintf(
+			w,
+			" SG_ %s : %d|%d@0%c (%s,%s) [%s|%s] %q %s\n",
+			signal.Name,
+			8*signal.Start+7,
+			8*signal.Size,
+			sign,
+			formatDBCFloat(signal.Factor),
+			formatDBCFloat(signal.Offset),
+			formatDBCFloat(signal.Min()),
+			formatDBCFloat(signal.Max()),
+			signal.Unit,
+			dbcNoReceiver,
+		)
+	}
+}
+
+func writeDBCAttributes(w *bufio.Writer, messages []dbcMessage) {
+	_, _ = w.WriteString("\n")
+	for _, message := range messages {
+		if message.hasCycleTime() {
+			_, _ = fmt.Fprintf(
+				w, "CM_ BO_ %d \"Xsens %v at %v.\";\n", message.dbcID(), message.definition.DataIdentifier, message.frequency,
+			)
+		}
+	}
+	_, _ = w.WriteString("BA_DEF_ BO_ \"GenMsgCycleTime\" INT 0 65535;\n")
+	_, _ = w.WriteString("BA_DEF_ BO_ \"VFrameFormat\" ENUM \"StandardCAN\",\"ExtendedCAN\";\n")
+	_, _ = w.WriteString("BA_DEF_DEF_ \"GenMsgCycleTime\" 0;\n")
+	_, _ = w.WriteString("BA_DEF_DEF_ \"VFrameFormat\" \"StandardCAN\";\n")
+	for _, message := range messages {
+		if message.hasCycleTime() {
+			cycleTime := int(math.Round(1000 / float64(message.frequency)))
+			_, _ = fmt.Fprintf(w, "BA_ \"GenMsgCycleTime\" BO_ %d %d;\n", message.dbcID(), cycleTime)
+		}
+		if message.extended {
+			_, _ = fmt.Fprintf(w, "BA_ \"VFrameFormat\" BO_ %d 1;\n", message.dbcID())
+		}
+	}
+}
+
+// dbcID returns the DBC representation of the CAN ID of the message.
+func (m *dbcMessage) dbcID() uint32 {
+	if m.extended {
+		return m.id | dbcExtendedIDFlag
+	}
+	return m.id
+}
+
+// hasCycleTime returns true if the message has a known cycle time.
+//
+// Frequencies of 0x0000 and 0xFFFF make the device select the maximum frequency of the data identifier.
+func (m *dbcMessage) hasCycleTime() bool {
+	return m.frequency != 0 && m.frequency != xsens.MaxOutputFrequency
+}
+
+// formatDBCFloat formats a float in the shortest representation, without exponent for integers.
+func formatDBCFloat(f float64) string {
+	if f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
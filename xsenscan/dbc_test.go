@@ -0,0 +1,71 @@
+package xsenscan_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"go.einride.tech/xsens"
+	"go.einride.tech/xsens/xsenscan"
+	"gotest.tools/v3/assert"
+)
+
+func TestWriteDBC(t *testing.T) {
+	configuration := xsens.CANOutputConfiguration{
+		{CANDataIdentifier: xsens.CANDataIdentifierSampleTime, OutputFrequency: 100},
+		{CANDataIdentifier: xsens.CANDataIdentifierQuaternion, OutputFrequency: 100},
+		{CANDataIdentifier: xsens.CANDataIdentifierUtcTime, OutputFrequency: xsens.MaxOutputFrequency},
+		{
+			CANDataIdentifier: xsens.CANDataIdentifierLatLong,
+			CANIDLengthFlag:   xsens.CANIDLengthFlag29bits,
+			IDMask:            0x1abc071,
+			OutputFrequency:   50,
+		},
+		{CANDataIdentifier: xsens.CANDataIdentifierGnssReceiverStatus, OutputFrequency: 4},
+	}
+	var actual strings.Builder
+	assert.NilError(t, xsenscan.WriteDBC(&actual, configuration))
+	const goldenFile = "testdata/xsens.dbc"
+	if shouldUpdateGoldenFiles() {
+		assert.NilError(t, os.WriteFile(goldenFile, []byte(actual.String()), 0o600))
+	}
+	expected, err := os.ReadFile(goldenFile)
+	assert.NilError(t, err)
+	assert.Equal(t, string(expected), actual.String())
+}
+
+func TestWriteDBC_Default(t *testing.T) {
+	var actual strings.Builder
+	assert.NilError(t, xsenscan.WriteDBC(&actual, nil))
+	assert.Equal(t, len(xsenscan.MessageDefinitions()), strings.Count(actual.String(), "\nBO_ "))
+	assert.Assert(t, strings.Contains(actual.String(), "\nBO_ 33 Quaternion: 8 Xsens\n"))
+	assert.Assert(t, !strings.Contains(actual.String(), "BA_ \"GenMsgCycleTime\""))
+}
+
+func TestWriteDBC_Errors(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		configuration xsens.CANOutputConfiguration
+		expected      string
+	}{
+		{
+			name:          "unsupported data identifier",
+			configuration: xsens.CANOutputConfiguration{{CANDataIdentifier: xsens.CANDataIdentifierInvalid}},
+			expected:      "unsupported data identifier: Invalid",
+		},
+		{
+			name: "duplicate CAN ID",
+			configuration: xsens.CANOutputConfiguration{
+				{CANDataIdentifier: xsens.CANDataIdentifierQuaternion},
+				{CANDataIdentifier: xsens.CANDataIdentifierEulerAngles, IDMask: 0x21},
+			},
+			expected: "Quaternion and EulerAngles have the same CAN ID: 0x21",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var actual strings.Builder
+			assert.ErrorContains(t, xsenscan.WriteDBC(&actual, tt.configuration), tt.expected)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package xsenscan
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"go.einride.tech/xsens"
+)
+
+// ErrUnknownFrame is returned when decoding a frame with a CAN ID that is not configured.
+var ErrUnknownFrame = errors.New("unknown frame")
+
+// Decoder decodes Xsens CAN frames into measurement data.
+//
+// The measurement data returned by Decode is overwritten by the next call to Decode.
+type Decoder struct {
+	ids map[uint32]xsens.CANDataIdentifier
+	// measurement data
+	errorCode          xsens.ErrorCode
+	warning            Warning
+	sampleTimeFine     xsens.SampleTimeFine
+	groupCounter       xsens.PacketCounter
+	utcTime            xsens.UTCTime
+	statusWord         xsens.StatusWord
+	quaternion         xsens.Quaternion
+	eulerAngles        xsens.EulerAngles
+	deltaV             xsens.DeltaV
+	rateOfTurn         xsens.RateOfTurn
+	deltaQ             xsens.DeltaQ
+	acceleration       xsens.Acceleration
+	freeAcceleration   xsens.FreeAcceleration
+	magneticField      xsens.MagneticField
+	temperature        xsens.Temperature
+	baroPressure       xsens.BaroPressure
+	rateOfTurnHR       xsens.RateOfTurnHR
+	accelerationHR     xsens.AccelerationHR
+	latLon             xsens.LatLon
+	altitudeEllipsoid  xsens.AltitudeEllipsoid
+	positionECEF       xsens.PositionECEF
+	velocityXYZ        xsens.VelocityXYZ
+	gnssReceiverStatus GNSSReceiverStatus
+	gnssReceiverDOP    GNSSReceiverDOP
+}
+
+// NewDecoder returns a new decoder resolving CAN IDs from the provided CAN output configuration.
+//
+// Settings without an ID mask use the default CAN ID of their data identifier. An empty configuration resolves
+// the default CAN IDs of all supported data identifiers.
+func NewDecoder(configuration xsens.CANOutputConfiguration) *Decoder {
+	d := &Decoder{ids: make(map[uint32]xsens.CANDataIdentifier)}
+	if len(configuration) == 0 {
+		for _, definition := range messageDefinitions {
+			d.ids[uint32(definition.DataIdentifier)] = definition.DataIdentifier
+		}
+		return d
+	}
+	for i := range configuration {
+		setting := &configuration[i]
+		id := setting.IDMask
+		if id == 0 {
+			id = setting.DefaultIDMask()
+		}
+		d.ids[id] = setting.CANDataIdentifier
+	}
+	return d
+}
+
+// DataIdentifier returns the data identifier of frames with the provided CAN ID.
+func (d *Decoder) DataIdentifier(id uint32) (xsens.CANDataIdentifier, bool) {
+	dataIdentifier, ok := d.ids[id]
+	return dataIdentifier, ok
+}
+
+// Decode decodes the frame and returns its data identifier and a pointer to its measurement data.
+//
+// The measurement data is of the Xsens measurement data type of the data identifier, such as *xsens.Quaternion for
+// CANDataIdentifierQuaternion, or of a type of this package for data without an Xsens measurement data type.
+// The PositionEcefX, PositionEcefY and PositionEcefZ messages each update a component of the same
+// *xsens.PositionECEF.
+func (d *Decoder) Decode(frame Frame) (xsens.CANDataIdentifier, interface{}, error) {
+	dataIdentifier, ok := d.ids[frame.ID]
+	if !ok {
+		return 0, nil, fmt.Errorf("xsens can: decode %v: %w", frame, ErrUnknownFrame)
+	}
+	definition, ok := LookupMessageDefinition(dataIdentifier)
+	if !ok {
+		return 0, nil, fmt.Errorf("xsens can: decode %v: unsupported data identifier: %v", frame, dataIdentifier)
+	}
+	if len(frame.Data) < definition.Length {
+		return 0, nil, fmt.Errorf(
+			"xsens can: decode %v: unexpected %v length: want: %d, got: %d",
+			frame,
+			dataIdentifier,
+			definition.Length,
+			len(frame.Data),
+		)
+	}
+	data := d.decode(&definition, frame.Data)
+	return dataIdentifier, data, nil
+}
+
+func (d *Decoder) decode(definition *MessageDefinition, payload []byte) interface{} {
+	value := func(i int) float64 {
+		return definition.Signals[i].Value(payload)
+	}
+	raw := func(i int) int64 {
+		return definition.Signals[i].Raw(payload)
+	}
+	vectorXYZ := func(v *xsens.VectorXYZ) *xsens.VectorXYZ {
+		*v = xsens.VectorXYZ{X: value(0), Y: value(1), Z: value(2)}
+		return v
+	}
+	quaternion := func(q *xsens.Quaternion) *xsens.Quaternion {
+		*q = xsens.Quaternion{Q0: value(0), Q1: value(1), Q2: value(2), Q3: value(3)}
+		return q
+	}
+	switch definition.DataIdentifier {
+	case xsens.CANDataIdentifierError:
+		d.errorCode = xsens.ErrorCode(raw(0))
+		return &d.errorCode
+	case xsens.CANDataIdentifierWarning:
+		d.warning = Warning(raw(0))
+		return &d.warning
+	case xsens.CANDataIdentifierSampleTime:
+		d.sampleTimeFine = xsens.SampleTimeFine(raw(0))
+		return &d.sampleTimeFine
+	case xsens.CANDataIdentifierGroupCounter:
+		d.groupCounter = xsens.PacketCounter(raw(0))
+		return &d.groupCounter
+	case xsens.CANDataIdentifierUtcTime:
+		d.utcTime = xsens.UTCTime{
+			Year:   uint16(value(0)),
+			Month:  uint8(raw(1)),
+			Day:    uint8(raw(2)),
+			Hour:   uint8(raw(3)),
+			Minute: uint8(raw(4)),
+			Second: uint8(raw(5)),
+			Ns:     uint32(raw(6)) * 100000,
+			Valid:  xsens.UTCDateValidFlag | xsens.UTCTimeOfDayValidFlag,
+		}
+		return &d.utcTime
+	case xsens.CANDataIdentifierStatusWord:
+		d.statusWord = xsens.StatusWord(raw(0))
+		return &d.statusWord
+	case xsens.CANDataIdentifierQuaternion:
+		return quaternion(&d.quaternion)
+	case xsens.CANDataIdentifierEulerAngles:
+		return vectorXYZ(&d.eulerAngles)
+	case xsens.CANDataIdentifierDeltaV:
+		return vectorXYZ(&d.deltaV)
+	case xsens.CANDataIdentifierRateOfTurn:
+		return vectorXYZ(&d.rateOfTurn)
+	case xsens.CANDataIdentifierDeltaQ:
+		return quaternion(&d.deltaQ)
+	case xsens.CANDataIdentifierAcceleration:
+		return vectorXYZ(&d.acceleration)
+	case xsens.CANDataIdentifierFreeAcceleration:
+		return vectorXYZ(&d.freeAcceleration)
+	case xsens.CANDataIdentifierMagneticField:
+		return vectorXYZ(&d.magneticField)
+	case xsens.CANDataIdentifierTemperature:
+		d.temperature = xsens.Temperature(value(0))
+		return &d.temperature
+	case xsens.CANDataIdentifierBaroPressure:
+		d.baroPressure = xsens.BaroPressure(raw(0))
+		return &d.baroPressure
+	case xsens.CANDataIdentifierRateOfTurnHR:
+		return vectorXYZ(&d.rateOfTurnHR)
+	case xsens.CANDataIdentifierAccelerationHR:
+		return vectorXYZ(&d.accelerationHR)
+	case xsens.CANDataIdentifierLatLong:
+		d.latLon = xsens.LatLon{Lat: value(0), Lon: value(1)}
+		return &d.latLon
+	case xsens.CANDataIdentifierAltitudeEllipsoid:
+		d.altitudeEllipsoid = xsens.AltitudeEllipsoid(value(0))
+		return &d.altitudeEllipsoid
+	case xsens.CANDataIdentifierPositionEcefX:
+		d.positionECEF.X = value(0)
+		return &d.positionECEF
+	case xsens.CANDataIdentifierPositionEcefY:
+		d.positionECEF.Y = value(0)
+		return &d.positionECEF
+	case xsens.CANDataIdentifierPositionEcefZ:
+		d.positionECEF.Z = value(0)
+		return &d.positionECEF
+	case xsens.CANDataIdentifierVelocityXYZ:
+		return vectorXYZ(&d.velocityXYZ)
+	case xsens.CANDataIdentifierGnssReceiverStatus:
+		d.gnssReceiverStatus = GNSSReceiverStatus{
+			FixType: xsens.FixType(raw(0)),
+			Flags:   uint8(raw(1)),
+			NumSV:   uint8(raw(2)),
+		}
+		return &d.gnssReceiverStatus
+	case xsens.CANDataIdentifierGnssReceiverDop:
+		d.gnssReceiverDOP = GNSSReceiverDOP{
+			PDOP: roundDOP(value(0)),
+			HDOP: roundDOP(value(1)),
+			VDOP: roundDOP(value(2)),
+			TDOP: roundDOP(value(3)),
+		}
+		return &d.gnssReceiverDOP
+	}
+	return nil
+}
+
+// roundDOP rounds a dilution of precision to its resolution of 0.01.
+func roundDOP(dop float64) float64 {
+	return math.Round(dop*100) / 100
+}
@@ -0,0 +1,167 @@
+package xsenscan_test
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"go.einride.tech/xsens"
+	"go.einride.tech/xsens/xsenscan"
+	"gotest.tools/v3/assert"
+)
+
+func shouldUpdateGoldenFiles() bool {
+	var flags flag.FlagSet
+	flags.SetOutput(io.Discard)
+	update := flags.Bool("update", false, "Update golden files.")
+	_ = flags.Parse(os.Args[1:]) // error will always be an unparsed flags error
+	return *update
+}
+
+func TestParseFrame(t *testing.T) {
+	for _, tt := range []struct {
+		s        string
+		expected xsenscan.Frame
+	}{
+		{s: "021#7FFF000000000000", expected: xsenscan.Frame{ID: 0x21, Data: []byte{0x7f, 0xff, 0, 0, 0, 0, 0, 0}}},
+		{s: "00000051#2A80", expected: xsenscan.Frame{ID: 0x51, Extended: true, Data: []byte{0x2a, 0x80}}},
+		{s: "005#", expected: xsenscan.Frame{ID: 0x05, Data: []byte{}}},
+	} {
+		tt := tt
+		t.Run(tt.s, func(t *testing.T) {
+			actual, err := xsenscan.ParseFrame(tt.s)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tt.expected, actual)
+			assert.Equal(t, strings.ToLower(tt.s), actual.String())
+		})
+	}
+	for _, s := range []string{"021", "xyz#00", "021#0", "021#000000000000000000"} {
+		_, err := xsenscan.ParseFrame(s)
+		assert.Assert(t, err != nil, s)
+	}
+}
+
+func TestDecoder_Decode(t *testing.T) {
+	decoder := xsenscan.NewDecoder(nil)
+	for _, tt := range []struct {
+		frame    string
+		expected interface{}
+	}{
+		{frame: "021#4000C000000020 00", expected: &xsens.Quaternion{Q0: 0.5, Q1: -0.5, Q3: 0.25}},
+		{frame: "022#00C0FF00D300", expected: &xsens.EulerAngles{X: 1.5, Y: -2, Z: -90}},
+		{frame: "034#0019FFCD0981", expected: &xsens.Acceleration{X: 0.09765625, Y: -0.19921875, Z: 9.50390625}},
+		{frame: "051#2A80", expected: func() *xsens.Temperature { v := xsens.Temperature(42.5); return &v }()},
+		{frame: "052#00018BCD", expected: func() *xsens.BaroPressure { v := xsens.BaroPressure(101325); return &v }()},
+		{frame: "071#40000000C0000000", expected: &xsens.LatLon{Lat: 64, Lon: -128}},
+		{frame: "079#033710", expected: &xsenscan.GNSSReceiverStatus{FixType: xsens.FixType3DFix, Flags: 0x37, NumSV: 16}},
+		{
+			frame:    "07A#009800570083005F",
+			expected: &xsenscan.GNSSReceiverDOP{PDOP: 1.52, HDOP: 0.87, VDOP: 1.31, TDOP: 0.95},
+		},
+	} {
+		tt := tt
+		t.Run(tt.frame, func(t *testing.T) {
+			frame, err := xsenscan.ParseFrame(strings.ReplaceAll(tt.frame, " ", ""))
+			assert.NilError(t, err)
+			_, actual, err := decoder.Decode(frame)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestDecoder_Decode_UTCTime(t *testing.T) {
+	decoder := xsenscan.NewDecoder(nil)
+	frame, err := xsenscan.ParseFrame("007#1A0A110C1E2D1388")
+	assert.NilError(t, err)
+	dataIdentifier, actual, err := decoder.Decode(frame)
+	assert.NilError(t, err)
+	assert.Equal(t, xsens.CANDataIdentifierUtcTime, dataIdentifier)
+	assert.Equal(t, "2026-10-17T12:30:45.5Z", actual.(*xsens.UTCTime).String())
+}
+
+func TestDecoder_Decode_PositionECEF(t *testing.T) {
+	decoder := xsenscan.NewDecoder(nil)
+	for _, s := range []string{"073#00000100", "074#FFFFFF00", "075#00000080"} {
+		frame, err := xsenscan.ParseFrame(s)
+		assert.NilError(t, err)
+		_, _, err = decoder.Decode(frame)
+		assert.NilError(t, err)
+	}
+	frame, err := xsenscan.ParseFrame("073#00000200")
+	assert.NilError(t, err)
+	_, actual, err := decoder.Decode(frame)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &xsens.PositionECEF{X: 2, Y: -1, Z: 0.5}, actual)
+}
+
+func TestDecoder_Decode_Errors(t *testing.T) {
+	decoder := xsenscan.NewDecoder(nil)
+	_, _, err := decoder.Decode(xsenscan.Frame{ID: 0x123, Data: []byte{1}})
+	assert.Assert(t, errors.Is(err, xsenscan.ErrUnknownFrame))
+	_, _, err = decoder.Decode(xsenscan.Frame{ID: 0x21, Data: []byte{1, 2}})
+	assert.ErrorContains(t, err, "unexpected Quaternion length")
+}
+
+func TestNewDecoder_Configuration(t *testing.T) {
+	decoder := xsenscan.NewDecoder(xsens.CANOutputConfiguration{
+		{CANDataIdentifier: xsens.CANDataIdentifierQuaternion},
+		{CANDataIdentifier: xsens.CANDataIdentifierEulerAngles, IDMask: 0x100},
+	})
+	dataIdentifier, ok := decoder.DataIdentifier(0x21)
+	assert.Assert(t, ok)
+	assert.Equal(t, xsens.CANDataIdentifierQuaternion, dataIdentifier)
+	dataIdentifier, ok = decoder.DataIdentifier(0x100)
+	assert.Assert(t, ok)
+	assert.Equal(t, xsens.CANDataIdentifierEulerAngles, dataIdentifier)
+	_, ok = decoder.DataIdentifier(0x22)
+	assert.Assert(t, !ok)
+}
+
+func TestDecoder_Decode_Fixture(t *testing.T) {
+	f, err := os.Open("testdata/frames.log")
+	assert.NilError(t, err)
+	defer func() {
+		assert.NilError(t, f.Close())
+	}()
+	decoder := xsenscan.NewDecoder(nil)
+	var actual strings.Builder
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		// candump log format: (<timestamp>) <interface> <frame>
+		fields := strings.Fields(sc.Text())
+		assert.Equal(t, 3, len(fields))
+		frame, err := xsenscan.ParseFrame(fields[2])
+		assert.NilError(t, err)
+		dataIdentifier, data, err := decoder.Decode(frame)
+		if err != nil {
+			_, _ = fmt.Fprintf(&actual, "%v\t%v\n", frame, err)
+			continue
+		}
+		_, _ = fmt.Fprintf(&actual, "%v\t%v\t%+v\n", frame, dataIdentifier, data)
+	}
+	assert.NilError(t, sc.Err())
+	const goldenFile = "testdata/frames.golden"
+	if shouldUpdateGoldenFiles() {
+		assert.NilError(t, os.WriteFile(goldenFile, []byte(actual.String()), 0o600))
+	}
+	expected, err := os.ReadFile(goldenFile)
+	assert.NilError(t, err)
+	assert.Equal(t, string(expected), actual.String())
+}
+
+func TestSignal_MinMax(t *testing.T) {
+	definition, ok := xsenscan.LookupMessageDefinition(xsens.CANDataIdentifierEulerAngles)
+	assert.Assert(t, ok)
+	assert.Equal(t, -256.0, definition.Signals[0].Min())
+	assert.Equal(t, 255.9921875, definition.Signals[0].Max())
+	definition, ok = xsenscan.LookupMessageDefinition(xsens.CANDataIdentifierUtcTime)
+	assert.Assert(t, ok)
+	assert.Equal(t, 2000.0, definition.Signals[0].Min())
+	assert.Equal(t, 2255.0, definition.Signals[0].Max())
+}
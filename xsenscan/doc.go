@@ -0,0 +1,2 @@
+// Package xsenscan decodes the CAN output of Xsens devices into Xsens measurement data.
+package xsenscan
@@ -0,0 +1,51 @@
+package xsenscan
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxFrameDataLength is the maximum data length of a classic CAN frame.
+const MaxFrameDataLength = 8
+
+// Frame is a CAN frame.
+type Frame struct {
+	// ID is the CAN identifier of the frame.
+	ID uint32
+	// Extended is true if the frame has a 29 bit extended identifier.
+	Extended bool
+	// Data is the payload of the frame.
+	Data []byte
+}
+
+// String returns the frame in the compact candump format, such as "021#7fff000000000000".
+func (f Frame) String() string {
+	if f.Extended {
+		return fmt.Sprintf("%08x#%s", f.ID, hex.EncodeToString(f.Data))
+	}
+	return fmt.Sprintf("%03x#%s", f.ID, hex.EncodeToString(f.Data))
+}
+
+// ParseFrame parses a frame in the compact candump format, such as "021#7fff000000000000".
+//
+// Identifiers of more than 3 hexadecimal digits are parsed as extended identifiers.
+func ParseFrame(s string) (Frame, error) {
+	id, data, ok := strings.Cut(s, "#")
+	if !ok {
+		return Frame{}, fmt.Errorf("parse frame %q: missing '#' separator", s)
+	}
+	parsedID, err := strconv.ParseUint(id, 16, 29)
+	if err != nil {
+		return Frame{}, fmt.Errorf("parse frame %q: %w", s, err)
+	}
+	parsedData, err := hex.DecodeString(data)
+	if err != nil {
+		return Frame{}, fmt.Errorf("parse frame %q: %w", s, err)
+	}
+	if len(parsedData) > MaxFrameDataLength {
+		return Frame{}, fmt.Errorf("parse frame %q: data too long: %d bytes", s, len(parsedData))
+	}
+	return Frame{ID: uint32(parsedID), Extended: len(id) > 3, Data: parsedData}, nil
+}
@@ -0,0 +1,30 @@
+package xsenscan
+
+import (
+	"fmt"
+
+	"go.einride.tech/xsens"
+)
+
+// Warning contains the warning flags of an Xsens CAN Warning message.
+type Warning uint32
+
+// String returns a string representation of the warning flags.
+func (w *Warning) String() string {
+	return fmt.Sprintf("%#08x", uint32(*w))
+}
+
+// GNSSReceiverStatus contains the GNSS receiver status of an Xsens CAN GnssReceiverStatus message.
+type GNSSReceiverStatus struct {
+	// FixType is the GNSS fix type.
+	FixType xsens.FixType
+	// Flags are the fix status flags, as in GNSSPVTData.
+	Flags uint8
+	// NumSV is the number of satellites used in the navigation solution.
+	NumSV uint8
+}
+
+// GNSSReceiverDOP contains the dilutions of precision of an Xsens CAN GnssReceiverDop message.
+type GNSSReceiverDOP struct {
+	PDOP, HDOP, VDOP, TDOP float64
+}
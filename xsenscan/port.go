@@ -0,0 +1,168 @@
+package xsenscan
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	"go.einride.tech/xsens"
+)
+
+// FrameReader reads CAN frames.
+type FrameReader interface {
+	// ReadFrame reads the next CAN frame.
+	ReadFrame() (Frame, error)
+}
+
+// dataTypes maps CAN data identifiers to the data types of their MTData2 packets.
+var dataTypes = map[xsens.CANDataIdentifier]xsens.DataType{
+	xsens.CANDataIdentifierSampleTime:        xsens.DataTypeSampleTimeFine,
+	xsens.CANDataIdentifierGroupCounter:      xsens.DataTypePacketCounter,
+	xsens.CANDataIdentifierUtcTime:           xsens.DataTypeUTCTime,
+	xsens.CANDataIdentifierStatusWord:        xsens.DataTypeStatusWord,
+	xsens.CANDataIdentifierQuaternion:        xsens.DataTypeQuaternion,
+	xsens.CANDataIdentifierEulerAngles:       xsens.DataTypeEulerAngles,
+	xsens.CANDataIdentifierDeltaV:            xsens.DataTypeDeltaV,
+	xsens.CANDataIdentifierRateOfTurn:        xsens.DataTypeRateOfTurn,
+	xsens.CANDataIdentifierDeltaQ:            xsens.DataTypeDeltaQ,
+	xsens.CANDataIdentifierAcceleration:      xsens.DataTypeAcceleration,
+	xsens.CANDataIdentifierFreeAcceleration:  xsens.DataTypeFreeAcceleration,
+	xsens.CANDataIdentifierMagneticField:     xsens.DataTypeMagneticField,
+	xsens.CANDataIdentifierTemperature:       xsens.DataTypeTemperature,
+	xsens.CANDataIdentifierBaroPressure:      xsens.DataTypeBaroPressure,
+	xsens.CANDataIdentifierRateOfTurnHR:      xsens.DataTypeRateOfTurnHR,
+	xsens.CANDataIdentifierAccelerationHR:    xsens.DataTypeAccelerationHR,
+	xsens.CANDataIdentifierLatLong:           xsens.DataTypeLatLon,
+	xsens.CANDataIdentifierAltitudeEllipsoid: xsens.DataTypeAltitudeEllipsoid,
+	xsens.CANDataIdentifierPositionEcefX:     xsens.DataTypePositionECEF,
+	xsens.CANDataIdentifierPositionEcefY:     xsens.DataTypePositionECEF,
+	xsens.CANDataIdentifierPositionEcefZ:     xsens.DataTypePositionECEF,
+	xsens.CANDataIdentifierVelocityXYZ:       xsens.DataTypeVelocityXYZ,
+}
+
+// Port provides the Xsens CAN frames read from a FrameReader as MTData2 messages, so that CAN output can be read
+// with the same xsens.Client, streaming and snapshot API as serial output.
+//
+// Frames are grouped into one MTData2 message per sample: a message is completed when a frame with a data
+// identifier already in the current group is read. Frames without an MTData2 equivalent, such as Error, Warning
+// and the GNSS receiver status, and frames with unknown CAN IDs are skipped. Malformed frames, such as frames with
+// an unexpected length, are skipped and counted, see Malformed.
+//
+// Writing is not supported, since Xsens devices are not configured over CAN.
+type Port struct {
+	r       FrameReader
+	decoder *Decoder
+	// group state
+	seen    [128]bool
+	packets []xsens.MTData2Packet
+	// read state
+	message   []byte
+	err       error
+	malformed uint64 // accessed atomically
+}
+
+var _ io.ReadWriteCloser = &Port{}
+
+// NewPort returns a new port reading frames from the FrameReader, decoded with the Decoder.
+//
+// If the FrameReader is an io.Closer, it is closed when the port is closed.
+func NewPort(r FrameReader, decoder *Decoder) *Port {
+	return &Port{r: r, decoder: decoder}
+}
+
+// Read the MTData2 messages of the frames read from the FrameReader.
+func (p *Port) Read(b []byte) (int, error) {
+	for len(p.message) == 0 {
+		if p.err != nil {
+			return 0, p.err
+		}
+		frame, err := p.r.ReadFrame()
+		if err != nil {
+			// complete the pending group before reporting the error
+			p.err = err
+			p.flush()
+			continue
+		}
+		if err := p.add(frame); err != nil {
+			// skip the frame, a single malformed frame should not stop the reading of subsequent samples
+			atomic.AddUint64(&p.malformed, 1)
+		}
+	}
+	n := copy(b, p.message)
+	p.message = p.message[n:]
+	return n, nil
+}
+
+// Malformed returns the number of malformed frames skipped.
+func (p *Port) Malformed() uint64 {
+	return atomic.LoadUint64(&p.malformed)
+}
+
+// Write is not supported.
+func (p *Port) Write([]byte) (int, error) {
+	return 0, fmt.Errorf("xsens can: write: not supported")
+}
+
+// Close the port, and the FrameReader if it is an io.Closer.
+func (p *Port) Close() error {
+	if c, ok := p.r.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// add the frame to the current group, completing the group first if the frame's data identifier is already in it.
+//
+// An error is returned for malformed frames, which are not added.
+func (p *Port) add(frame Frame) error {
+	dataIdentifier, data, err := p.decoder.Decode(frame)
+	if err != nil {
+		if errors.Is(err, ErrUnknownFrame) {
+			return nil
+		}
+		return err
+	}
+	dataType, ok := dataTypes[dataIdentifier]
+	if !ok {
+		return nil
+	}
+	measurementData, ok := data.(xsens.MeasurementData)
+	if !ok {
+		return fmt.Errorf("xsens can: %v: no MTData2 representation", dataIdentifier)
+	}
+	packet, err := measurementData.MarshalMTData2Packet(xsens.DataIdentifier{
+		DataType:  dataType,
+		Precision: xsens.PrecisionFloat64,
+	})
+	if err != nil {
+		return fmt.Errorf("xsens can: %v: %w", dataIdentifier, err)
+	}
+	if p.seen[dataIdentifier] {
+		p.flush()
+	}
+	p.seen[dataIdentifier] = true
+	for i := range p.packets {
+		// the position ECEF components share a single packet
+		if p.packets[i].Identifier().DataType == dataType {
+			p.packets[i] = packet
+			return nil
+		}
+	}
+	p.packets = append(p.packets, packet)
+	return nil
+}
+
+// flush completes the current group into an MTData2 message.
+func (p *Port) flush() {
+	if len(p.packets) == 0 {
+		return
+	}
+	var data []byte
+	for _, packet := range p.packets {
+		data = append(data, packet...)
+	}
+	p.message = append(p.message, xsens.NewMessage(xsens.MessageIdentifierMTData2, data)...)
+	p.packets = p.packets[:0]
+	p.seen = [128]bool{}
+}
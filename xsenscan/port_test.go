@@ -0,0 +1,102 @@
+package xsenscan_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/xsens"
+	"go.einride.tech/xsens/xsenscan"
+	"gotest.tools/v3/assert"
+)
+
+// fixtureFrameReader reads the frames of a candump log fixture, once started.
+type fixtureFrameReader struct {
+	started <-chan struct{}
+	frames  []xsenscan.Frame
+}
+
+func newFixtureFrameReader(t *testing.T, started <-chan struct{}) *fixtureFrameReader {
+	t.Helper()
+	f, err := os.Open("testdata/frames.log")
+	assert.NilError(t, err)
+	defer func() {
+		assert.NilError(t, f.Close())
+	}()
+	r := &fixtureFrameReader{started: started}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		frame, err := xsenscan.ParseFrame(fields[2])
+		assert.NilError(t, err)
+		r.frames = append(r.frames, frame)
+	}
+	assert.NilError(t, sc.Err())
+	return r
+}
+
+func (r *fixtureFrameReader) ReadFrame() (xsenscan.Frame, error) {
+	<-r.started
+	if len(r.frames) == 0 {
+		return xsenscan.Frame{}, io.EOF
+	}
+	frame := r.frames[0]
+	r.frames = r.frames[1:]
+	return frame, nil
+}
+
+func TestPort_Read(t *testing.T) {
+	started := make(chan struct{})
+	close(started)
+	port := xsenscan.NewPort(newFixtureFrameReader(t, started), xsenscan.NewDecoder(nil))
+	sc := bufio.NewScanner(port)
+	sc.Split(xsens.ScanMessages)
+	var messages []xsens.Message
+	for sc.Scan() {
+		message := xsens.Message(append([]byte(nil), sc.Bytes()...))
+		assert.NilError(t, message.Validate())
+		assert.Equal(t, xsens.MessageIdentifierMTData2, message.Identifier())
+		messages = append(messages, message)
+	}
+	assert.NilError(t, sc.Err())
+	// two samples, followed by an Error and a Warning frame without MTData2 representation
+	assert.Equal(t, 2, len(messages))
+	// the short Temperature frame of the second sample should have been skipped
+	assert.Equal(t, uint64(1), port.Malformed())
+	_, err := port.Write(nil)
+	assert.ErrorContains(t, err, "not supported")
+}
+
+func TestPort_Stream(t *testing.T) {
+	started := make(chan struct{})
+	port := xsenscan.NewPort(newFixtureFrameReader(t, started), xsenscan.NewDecoder(nil))
+	client := xsens.NewClient(port)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	stream := client.Stream(ctx)
+	subscription := stream.Subscribe(xsens.WithOverflowPolicy(xsens.OverflowPolicyBlock))
+	close(started)
+	first := <-subscription.Snapshots()
+	assert.Equal(t, xsens.SampleTimeFine(123456), *first.SampleTimeFine)
+	assert.Equal(t, xsens.PacketCounter(4242), *first.PacketCounter)
+	assert.Equal(t, "2026-10-17T12:30:45.5Z", first.UTCTime.String())
+	assert.Assert(t, first.StatusWord.FilterValid())
+	assert.DeepEqual(t, &xsens.EulerAngles{X: 1.5, Y: -2.25, Z: -90}, first.EulerAngles)
+	assert.Equal(t, xsens.Temperature(42.5), *first.Temperature)
+	assert.Equal(t, xsens.BaroPressure(101325), *first.BaroPressure)
+	assert.DeepEqual(t, &xsens.PositionECEF{X: 3308000.5, Y: 701500.25, Z: 5370000.75}, first.PositionECEF)
+	assert.DeepEqual(t, &xsens.VelocityXYZ{X: 10.5, Y: -0.25}, first.VelocityXYZ)
+	assert.Assert(t, first.Has(xsens.DataTypeLatLon))
+	assert.Assert(t, !first.Has(xsens.DataTypeGNSSPVTData))
+	second := <-subscription.Snapshots()
+	assert.Equal(t, xsens.PacketCounter(4243), *second.PacketCounter)
+	assert.DeepEqual(t, &xsens.EulerAngles{X: 1.5, Y: -2.25, Z: -89}, second.EulerAngles)
+	assert.Equal(t, xsens.Temperature(42.5), *second.Temperature)
+	assert.Assert(t, errors.Is(stream.Wait(), io.EOF))
+}
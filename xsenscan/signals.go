@@ -0,0 +1,277 @@
+package xsenscan
+
+import (
+	"math"
+
+	"go.einride.tech/xsens"
+)
+
+// Signal is a scaled value in the payload of an Xsens CAN message.
+//
+// Values are big-endian integers, the physical value being Raw*Factor + Offset.
+type Signal struct {
+	// Name is the name of the signal.
+	Name string
+	// Start is the byte offset of the signal in the payload.
+	Start int
+	// Size is the size of the signal in bytes.
+	Size int
+	// Signed is true if the signal is a two's complement signed integer.
+	Signed bool
+	// Factor is the scale factor of the signal.
+	Factor float64
+	// Offset is the offset of the signal.
+	Offset float64
+	// Unit is the unit of the physical value.
+	Unit string
+}
+
+// Value returns the physical value of the signal in the payload.
+func (s *Signal) Value(data []byte) float64 {
+	return float64(s.Raw(data))*s.Factor + s.Offset
+}
+
+// Raw returns the raw integer value of the signal in the payload, sign extended if the signal is signed.
+func (s *Signal) Raw(data []byte) int64 {
+	var raw uint64
+	for _, b := range data[s.Start : s.Start+s.Size] {
+		raw = raw<<8 | uint64(b)
+	}
+	if s.Signed {
+		shift := 64 - 8*s.Size
+		return int64(raw<<shift) >> shift
+	}
+	return int64(raw)
+}
+
+// Min returns the minimum physical value of the signal.
+func (s *Signal) Min() float64 {
+	if s.Signed {
+		return -math.Ldexp(1, 8*s.Size-1)*s.Factor + s.Offset
+	}
+	return s.Offset
+}
+
+// Max returns the maximum physical value of the signal.
+func (s *Signal) Max() float64 {
+	if s.Signed {
+		return (math.Ldexp(1, 8*s.Size-1)-1)*s.Factor + s.Offset
+	}
+	return (math.Ldexp(1, 8*s.Size)-1)*s.Factor + s.Offset
+}
+
+// MessageDefinition defines the payload of the CAN message of a data identifier.
+type MessageDefinition struct {
+	// DataIdentifier is the data identifier of the message.
+	DataIdentifier xsens.CANDataIdentifier
+	// Length is the payload length of the message in bytes.
+	Length int
+	// Signals are the signals of the message.
+	Signals []Signal
+}
+
+// scale factors of the Xsens CAN messages.
+var (
+	scale2Pow6  = math.Ldexp(1, -6)
+	scale2Pow7  = math.Ldexp(1, -7)
+	scale2Pow8  = math.Ldexp(1, -8)
+	scale2Pow9  = math.Ldexp(1, -9)
+	scale2Pow10 = math.Ldexp(1, -10)
+	scale2Pow11 = math.Ldexp(1, -11)
+	scale2Pow15 = math.Ldexp(1, -15)
+	scale2Pow16 = math.Ldexp(1, -16)
+	scale2Pow23 = math.Ldexp(1, -23)
+	scale2Pow24 = math.Ldexp(1, -24)
+)
+
+// vectorXYZSignals returns signals for a vector of three signed 16 bit components.
+func vectorXYZSignals(prefix string, factor float64, unit string) []Signal {
+	return []Signal{
+		{Name: prefix + "X", Start: 0, Size: 2, Signed: true, Factor: factor, Unit: unit},
+		{Name: prefix + "Y", Start: 2, Size: 2, Signed: true, Factor: factor, Unit: unit},
+		{Name: prefix + "Z", Start: 4, Size: 2, Signed: true, Factor: factor, Unit: unit},
+	}
+}
+
+// quaternionSignals returns signals for a quaternion of four signed 16 bit components.
+func quaternionSignals(prefix string) []Signal {
+	return []Signal{
+		{Name: prefix + "Q0", Start: 0, Size: 2, Signed: true, Factor: scale2Pow15},
+		{Name: prefix + "Q1", Start: 2, Size: 2, Signed: true, Factor: scale2Pow15},
+		{Name: prefix + "Q2", Start: 4, Size: 2, Signed: true, Factor: scale2Pow15},
+		{Name: prefix + "Q3", Start: 6, Size: 2, Signed: true, Factor: scale2Pow15},
+	}
+}
+
+// messageDefinitions are the definitions of the Xsens CAN messages, ordered by data identifier.
+var messageDefinitions = []MessageDefinition{
+	{
+		DataIdentifier: xsens.CANDataIdentifierError,
+		Length:         1,
+		Signals:        []Signal{{Name: "ErrorCode", Size: 1, Factor: 1}},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierWarning,
+		Length:         4,
+		Signals:        []Signal{{Name: "Warning", Size: 4, Factor: 1}},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierSampleTime,
+		Length:         4,
+		Signals:        []Signal{{Name: "SampleTimeFine", Size: 4, Factor: 1}},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierGroupCounter,
+		Length:         2,
+		Signals:        []Signal{{Name: "GroupCounter", Size: 2, Factor: 1}},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierUtcTime,
+		Length:         8,
+		Signals: []Signal{
+			{Name: "Year", Start: 0, Size: 1, Factor: 1, Offset: 2000, Unit: "y"},
+			{Name: "Month", Start: 1, Size: 1, Factor: 1},
+			{Name: "Day", Start: 2, Size: 1, Factor: 1, Unit: "d"},
+			{Name: "Hour", Start: 3, Size: 1, Factor: 1, Unit: "h"},
+			{Name: "Minute", Start: 4, Size: 1, Factor: 1, Unit: "min"},
+			{Name: "Second", Start: 5, Size: 1, Factor: 1, Unit: "s"},
+			{Name: "TenthMs", Start: 6, Size: 2, Factor: 1, Unit: "0.1 ms"},
+		},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierStatusWord,
+		Length:         4,
+		Signals:        []Signal{{Name: "StatusWord", Size: 4, Factor: 1}},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierQuaternion,
+		Length:         8,
+		Signals:        quaternionSignals(""),
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierEulerAngles,
+		Length:         6,
+		Signals: []Signal{
+			{Name: "Roll", Start: 0, Size: 2, Signed: true, Factor: scale2Pow7, Unit: "deg"},
+			{Name: "Pitch", Start: 2, Size: 2, Signed: true, Factor: scale2Pow7, Unit: "deg"},
+			{Name: "Yaw", Start: 4, Size: 2, Signed: true, Factor: scale2Pow7, Unit: "deg"},
+		},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierDeltaV,
+		Length:         6,
+		Signals:        vectorXYZSignals("DeltaV", scale2Pow16, "m/s"),
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierRateOfTurn,
+		Length:         6,
+		Signals:        vectorXYZSignals("Gyr", scale2Pow9, "rad/s"),
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierDeltaQ,
+		Length:         8,
+		Signals:        quaternionSignals("DeltaQ"),
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierAcceleration,
+		Length:         6,
+		Signals:        vectorXYZSignals("Acc", scale2Pow8, "m/s^2"),
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierFreeAcceleration,
+		Length:         6,
+		Signals:        vectorXYZSignals("FreeAcc", scale2Pow8, "m/s^2"),
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierMagneticField,
+		Length:         6,
+		Signals:        vectorXYZSignals("Mag", scale2Pow10, "a.u."),
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierTemperature,
+		Length:         2,
+		Signals:        []Signal{{Name: "Temperature", Size: 2, Signed: true, Factor: scale2Pow8, Unit: "degC"}},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierBaroPressure,
+		Length:         4,
+		Signals:        []Signal{{Name: "BaroPressure", Size: 4, Factor: 1, Unit: "Pa"}},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierRateOfTurnHR,
+		Length:         6,
+		Signals:        vectorXYZSignals("GyrHR", scale2Pow11, "rad/s"),
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierAccelerationHR,
+		Length:         6,
+		Signals:        vectorXYZSignals("AccHR", scale2Pow8, "m/s^2"),
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierLatLong,
+		Length:         8,
+		Signals: []Signal{
+			{Name: "Latitude", Start: 0, Size: 4, Signed: true, Factor: scale2Pow24, Unit: "deg"},
+			{Name: "Longitude", Start: 4, Size: 4, Signed: true, Factor: scale2Pow23, Unit: "deg"},
+		},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierAltitudeEllipsoid,
+		Length:         4,
+		Signals:        []Signal{{Name: "AltEllipsoid", Size: 4, Signed: true, Factor: scale2Pow15, Unit: "m"}},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierPositionEcefX,
+		Length:         4,
+		Signals:        []Signal{{Name: "PositionEcefX", Size: 4, Signed: true, Factor: scale2Pow8, Unit: "m"}},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierPositionEcefY,
+		Length:         4,
+		Signals:        []Signal{{Name: "PositionEcefY", Size: 4, Signed: true, Factor: scale2Pow8, Unit: "m"}},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierPositionEcefZ,
+		Length:         4,
+		Signals:        []Signal{{Name: "PositionEcefZ", Size: 4, Signed: true, Factor: scale2Pow8, Unit: "m"}},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierVelocityXYZ,
+		Length:         6,
+		Signals:        vectorXYZSignals("Vel", scale2Pow6, "m/s"),
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierGnssReceiverStatus,
+		Length:         3,
+		Signals: []Signal{
+			{Name: "FixType", Start: 0, Size: 1, Factor: 1},
+			{Name: "Flags", Start: 1, Size: 1, Factor: 1},
+			{Name: "NumSV", Start: 2, Size: 1, Factor: 1},
+		},
+	},
+	{
+		DataIdentifier: xsens.CANDataIdentifierGnssReceiverDop,
+		Length:         8,
+		Signals: []Signal{
+			{Name: "PDOP", Start: 0, Size: 2, Factor: 0.01},
+			{Name: "HDOP", Start: 2, Size: 2, Factor: 0.01},
+			{Name: "VDOP", Start: 4, Size: 2, Factor: 0.01},
+			{Name: "TDOP", Start: 6, Size: 2, Factor: 0.01},
+		},
+	},
+}
+
+// MessageDefinitions returns the definitions of all supported Xsens CAN messages, ordered by data identifier.
+func MessageDefinitions() []MessageDefinition {
+	return append([]MessageDefinition(nil), messageDefinitions...)
+}
+
+// LookupMessageDefinition returns the definition of the CAN message of the data identifier.
+func LookupMessageDefinition(id xsens.CANDataIdentifier) (MessageDefinition, bool) {
+	for _, definition := range messageDefinitions {
+		if definition.DataIdentifier == id {
+			return definition, true
+		}
+	}
+	return MessageDefinition{}, false
+}
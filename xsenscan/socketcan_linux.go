@@ -0,0 +1,103 @@
+package xsenscan
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// canFrame is the Linux struct can_frame.
+type canFrame struct {
+	id   uint32
+	len  uint8
+	_    [3]uint8
+	data [MaxFrameDataLength]uint8
+}
+
+// SocketCAN is a Linux SocketCAN raw socket for reading and writing classic CAN frames.
+//
+// Remote transmission request and error frames are skipped when reading.
+type SocketCAN struct {
+	f *os.File
+}
+
+var _ FrameReader = &SocketCAN{}
+
+// DialSocketCAN opens a raw socket bound to the SocketCAN network interface, such as "can0" or "vcan0".
+func DialSocketCAN(iface string) (*SocketCAN, error) {
+	ifi, err := net.InterfaceByName(iface)
+	if err != nil {
+		return nil, fmt.Errorf("xsens can: dial %s: %w", iface, err)
+	}
+	fd, err := unix.Socket(unix.AF_CAN, unix.SOCK_RAW|unix.SOCK_NONBLOCK|unix.SOCK_CLOEXEC, unix.CAN_RAW)
+	if err != nil {
+		return nil, fmt.Errorf("xsens can: dial %s: %w", iface, err)
+	}
+	if err := unix.Bind(fd, &unix.SockaddrCAN{Ifindex: ifi.Index}); err != nil {
+		_ = unix.Close(fd)
+		return nil, fmt.Errorf("xsens can: dial %s: %w", iface, err)
+	}
+	// the non-blocking socket is registered with the runtime poller, supporting deadlines and concurrent Close
+	return &SocketCAN{f: os.NewFile(uintptr(fd), iface)}, nil
+}
+
+// ReadFrame reads the next data frame from the socket.
+func (s *SocketCAN) ReadFrame() (Frame, error) {
+	var frame canFrame
+	buf := (*[unsafe.Sizeof(frame)]byte)(unsafe.Pointer(&frame))[:]
+	for {
+		n, err := s.f.Read(buf)
+		if err != nil {
+			return Frame{}, fmt.Errorf("xsens can: read frame: %w", err)
+		}
+		if n != len(buf) {
+			return Frame{}, fmt.Errorf("xsens can: read frame: unexpected length: want: %d, got: %d", len(buf), n)
+		}
+		if frame.id&(unix.CAN_RTR_FLAG|unix.CAN_ERR_FLAG) != 0 {
+			continue
+		}
+		length := int(frame.len)
+		if length > MaxFrameDataLength {
+			length = MaxFrameDataLength
+		}
+		result := Frame{Data: append([]byte(nil), frame.data[:length]...)}
+		if frame.id&unix.CAN_EFF_FLAG != 0 {
+			result.ID = frame.id & unix.CAN_EFF_MASK
+			result.Extended = true
+		} else {
+			result.ID = frame.id & unix.CAN_SFF_MASK
+		}
+		return result, nil
+	}
+}
+
+// WriteFrame writes a data frame to the socket.
+func (s *SocketCAN) WriteFrame(frame Frame) error {
+	if len(frame.Data) > MaxFrameDataLength {
+		return fmt.Errorf("xsens can: write frame %v: data too long: %d bytes", frame, len(frame.Data))
+	}
+	raw := canFrame{id: frame.ID, len: uint8(len(frame.Data))}
+	if frame.Extended {
+		raw.id = frame.ID&unix.CAN_EFF_MASK | unix.CAN_EFF_FLAG
+	}
+	copy(raw.data[:], frame.Data)
+	buf := (*[unsafe.Sizeof(raw)]byte)(unsafe.Pointer(&raw))[:]
+	if _, err := s.f.Write(buf); err != nil {
+		return fmt.Errorf("xsens can: write frame %v: %w", frame, err)
+	}
+	return nil
+}
+
+// SetReadDeadline sets the deadline for pending and future reads.
+func (s *SocketCAN) SetReadDeadline(t time.Time) error {
+	return s.f.SetReadDeadline(t)
+}
+
+// Close the socket, aborting pending reads.
+func (s *SocketCAN) Close() error {
+	return s.f.Close()
+}
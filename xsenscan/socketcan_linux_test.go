@@ -0,0 +1,52 @@
+package xsenscan_test
+
+import (
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"go.einride.tech/xsens/xsenscan"
+	"gotest.tools/v3/assert"
+)
+
+// socketCANTestInterface returns the virtual CAN interface used for testing, skipping the test if it is missing.
+//
+// Set up with:
+//
+//	ip link add dev vcan0 type vcan && ip link set up vcan0
+func socketCANTestInterface(t *testing.T) string {
+	t.Helper()
+	iface := os.Getenv("XSENS_TEST_VCAN")
+	if iface == "" {
+		iface = "vcan0"
+	}
+	if _, err := net.InterfaceByName(iface); err != nil {
+		t.Skipf("virtual CAN interface %s not available: %v", iface, err)
+	}
+	return iface
+}
+
+func TestSocketCAN(t *testing.T) {
+	iface := socketCANTestInterface(t)
+	r, err := xsenscan.DialSocketCAN(iface)
+	assert.NilError(t, err)
+	defer func() {
+		assert.NilError(t, r.Close())
+	}()
+	w, err := xsenscan.DialSocketCAN(iface)
+	assert.NilError(t, err)
+	defer func() {
+		assert.NilError(t, w.Close())
+	}()
+	for _, expected := range []xsenscan.Frame{
+		{ID: 0x21, Data: []byte{0x5a, 0x82, 0x00, 0x00, 0x01, 0x93, 0xa5, 0x7e}},
+		{ID: 0x1abcdef, Extended: true, Data: []byte{0x2a, 0x80}},
+	} {
+		assert.NilError(t, w.WriteFrame(expected))
+		assert.NilError(t, r.SetReadDeadline(time.Now().Add(time.Second)))
+		actual, err := r.ReadFrame()
+		assert.NilError(t, err)
+		assert.DeepEqual(t, expected, actual)
+	}
+}
@@ -0,0 +1,164 @@
+package xsensexport
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+
+	"go.einride.tech/xsens"
+)
+
+// CSVWriter writes snapshots as CSV, with one row per snapshot.
+//
+// The first column contains the receive time of the snapshot, followed by one column for each value of the data
+// types in the output configuration, such as Acceleration.X. Values that are missing from a snapshot are left empty.
+// Variable length data, such as the satellites of GNSSSatInfo, is not exported.
+type CSVWriter struct {
+	w             *csv.Writer
+	columns       []column
+	record        []string
+	headerWritten bool
+}
+
+var _ Writer = &CSVWriter{}
+
+// column is a CSV column.
+type column struct {
+	name  string
+	value func(*xsens.Snapshot) string
+}
+
+// NewCSVWriter returns a new CSV writer, with columns for the data types in the provided output configuration.
+func NewCSVWriter(w io.Writer, config xsens.OutputConfiguration) *CSVWriter {
+	columns := []column{
+		{name: "Time"},
+	}
+	seen := map[xsens.DataType]bool{}
+	for _, setting := range config {
+		dataType := setting.DataIdentifier.DataType
+		if seen[dataType] {
+			continue
+		}
+		seen[dataType] = true
+		columns = append(columns, dataTypeColumns(dataType)...)
+	}
+	return &CSVWriter{
+		w:       csv.NewWriter(w),
+		columns: columns,
+		record:  make([]string, len(columns)),
+	}
+}
+
+// Header returns the names of the CSV columns.
+func (c *CSVWriter) Header() []string {
+	header := make([]string, 0, len(c.columns))
+	for _, col := range c.columns {
+		header = append(header, col.name)
+	}
+	return header
+}
+
+// Write a snapshot as a CSV row, preceded by the CSV header for the first snapshot.
+func (c *CSVWriter) Write(t time.Time, s *xsens.Snapshot) error {
+	if !c.headerWritten {
+		if err := c.w.Write(c.Header()); err != nil {
+			return fmt.Errorf("xsens export: write CSV header: %w", err)
+		}
+		c.headerWritten = true
+	}
+	c.record[0] = ""
+	if !t.IsZero() {
+		c.record[0] = t.UTC().Format(time.RFC3339Nano)
+	}
+	for i := 1; i < len(c.columns); i++ {
+		c.record[i] = c.columns[i].value(s)
+	}
+	if err := c.w.Write(c.record); err != nil {
+		return fmt.Errorf("xsens export: write CSV: %w", err)
+	}
+	return nil
+}
+
+// Flush any buffered data to the underlying writer.
+func (c *CSVWriter) Flush() error {
+	c.w.Flush()
+	if err := c.w.Error(); err != nil {
+		return fmt.Errorf("xsens export: flush CSV: %w", err)
+	}
+	return nil
+}
+
+// dataTypeColumns returns the columns for the values of a data type.
+//
+// Returns no columns for data types without a field in xsens.Snapshot.
+func dataTypeColumns(dataType xsens.DataType) []column {
+	var zero xsens.Snapshot
+	data := zero.NewMeasurementData(dataType)
+	if _, ok := data.(*xsens.RawMeasurement); ok {
+		return nil
+	}
+	var columns []column
+	var walk func(name string, t reflect.Type, index []int)
+	walk = func(name string, t reflect.Type, index []int) {
+		switch {
+		case t == reflect.TypeOf(xsens.UTCTime{}):
+			columns = append(columns, newColumn(name, dataType, index, func(v reflect.Value) string {
+				utcTime := v.Interface().(xsens.UTCTime)
+				return utcTime.Time().Format(time.RFC3339Nano)
+			}))
+		case t.Kind() == reflect.Struct:
+			for i := 0; i < t.NumField(); i++ {
+				if f := t.Field(i); f.IsExported() {
+					walk(name+"."+f.Name, f.Type, append(index[:len(index):len(index)], i))
+				}
+			}
+		default:
+			if format := formatFunc(t.Kind()); format != nil {
+				columns = append(columns, newColumn(name, dataType, index, format))
+			}
+		}
+	}
+	walk(dataType.String(), reflect.TypeOf(data).Elem(), nil)
+	return columns
+}
+
+// newColumn returns a column formatting the value at the index path of the measurement data of a data type.
+func newColumn(name string, dataType xsens.DataType, index []int, format func(reflect.Value) string) column {
+	return column{
+		name: name,
+		value: func(s *xsens.Snapshot) string {
+			data := s.MeasurementData(dataType)
+			if data == nil {
+				return ""
+			}
+			v := reflect.ValueOf(data).Elem()
+			if len(index) > 0 {
+				v = v.FieldByIndex(index)
+			}
+			return format(v)
+		},
+	}
+}
+
+// formatFunc returns a function formatting values of a kind, or nil if the kind is not supported.
+func formatFunc(kind reflect.Kind) func(reflect.Value) string {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(v reflect.Value) string {
+			return strconv.FormatInt(v.Int(), 10)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return func(v reflect.Value) string {
+			return strconv.FormatUint(v.Uint(), 10)
+		}
+	case reflect.Float32, reflect.Float64:
+		return func(v reflect.Value) string {
+			return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+		}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,31 @@
+package xsensexport
+
+import (
+	"reflect"
+	"testing"
+
+	"go.einride.tech/xsens"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataTypeColumns(t *testing.T) {
+	// every measurement data field of the snapshot should be exported
+	var n int
+	for dataType := xsens.DataType(0); dataType < 0xfff0; dataType += 0x10 {
+		var s xsens.Snapshot
+		if _, ok := s.NewMeasurementData(dataType).(*xsens.RawMeasurement); ok {
+			assert.Equal(t, 0, len(dataTypeColumns(dataType)), dataType)
+			continue
+		}
+		n++
+		assert.Assert(t, len(dataTypeColumns(dataType)) > 0, dataType)
+	}
+	snapshotType := reflect.TypeOf(xsens.Snapshot{})
+	var fields int
+	for i := 0; i < snapshotType.NumField(); i++ {
+		if snapshotType.Field(i).Type.Kind() == reflect.Ptr {
+			fields++
+		}
+	}
+	assert.Equal(t, fields, n)
+}
@@ -0,0 +1,2 @@
+// Package xsensexport provides writers for exporting Xsens measurement data to analysis friendly formats.
+package xsensexport
@@ -0,0 +1,80 @@
+package xsensexport_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"go.einride.tech/xsens"
+	"go.einride.tech/xsens/xsensexport"
+	"gotest.tools/v3/assert"
+)
+
+func TestCSVWriter(t *testing.T) {
+	config := xsens.OutputConfiguration{
+		{DataIdentifier: xsens.DataIdentifier{DataType: xsens.DataTypePacketCounter}},
+		{DataIdentifier: xsens.DataIdentifier{DataType: xsens.DataTypeUTCTime}},
+		{DataIdentifier: xsens.DataIdentifier{DataType: xsens.DataTypeAcceleration}},
+		{DataIdentifier: xsens.DataIdentifier{DataType: xsens.DataTypeStatusWord}},
+		{DataIdentifier: xsens.DataIdentifier{DataType: xsens.DataTypeGNSSSatInfo}},
+		{DataIdentifier: xsens.DataIdentifier{DataType: xsens.DataTypeAcceleration, Precision: xsens.PrecisionFloat64}},
+	}
+	var buf bytes.Buffer
+	w := xsensexport.NewCSVWriter(&buf, config)
+	assert.DeepEqual(
+		t,
+		[]string{
+			"Time",
+			"PacketCounter",
+			"UTCTime",
+			"Acceleration.X",
+			"Acceleration.Y",
+			"Acceleration.Z",
+			"StatusWord",
+			"GNSSSatInfo.ITOW",
+			"GNSSSatInfo.NumSVS",
+			"GNSSSatInfo.Res1",
+			"GNSSSatInfo.Res2",
+			"GNSSSatInfo.Res3",
+		},
+		w.Header(),
+	)
+	packetCounter := xsens.PacketCounter(1)
+	var utcTime xsens.UTCTime
+	utcTime.UnmarshalTime(time.Date(2019, 1, 20, 13, 47, 24, 121900000, time.UTC))
+	statusWord := xsens.StatusWord(7)
+	assert.NilError(t, w.Write(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), &xsens.Snapshot{
+		PacketCounter: &packetCounter,
+		UTCTime:       &utcTime,
+		Acceleration:  &xsens.Acceleration{X: 0.5, Y: -1, Z: 9.81},
+		StatusWord:    &statusWord,
+	}))
+	assert.NilError(t, w.Write(time.Time{}, &xsens.Snapshot{PacketCounter: &packetCounter}))
+	assert.NilError(t, w.Flush())
+	assert.Equal(
+		t,
+		"Time,PacketCounter,UTCTime,Acceleration.X,Acceleration.Y,Acceleration.Z,StatusWord,"+
+			"GNSSSatInfo.ITOW,GNSSSatInfo.NumSVS,GNSSSatInfo.Res1,GNSSSatInfo.Res2,GNSSSatInfo.Res3\n"+
+			"2020-01-02T03:04:05Z,1,2019-01-20T13:47:24.1219Z,0.5,-1,9.81,7,,,,,\n"+
+			",1,,,,,,,,,,\n",
+		buf.String(),
+	)
+}
+
+func TestJSONLinesWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := xsensexport.NewJSONLinesWriter(&buf)
+	packetCounter := xsens.PacketCounter(1)
+	assert.NilError(t, w.Write(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), &xsens.Snapshot{
+		PacketCounter: &packetCounter,
+		Acceleration:  &xsens.Acceleration{X: 0.5, Y: -1, Z: 9.81},
+	}))
+	assert.NilError(t, w.Write(time.Time{}, &xsens.Snapshot{PacketCounter: &packetCounter}))
+	assert.NilError(t, w.Flush())
+	assert.Equal(
+		t,
+		`{"Time":"2020-01-02T03:04:05Z","PacketCounter":1,"Acceleration":{"X":0.5,"Y":-1,"Z":9.81}}`+"\n"+
+			`{"PacketCounter":1}`+"\n",
+		buf.String(),
+	)
+}
@@ -0,0 +1,54 @@
+package xsensexport
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"go.einride.tech/xsens"
+)
+
+// JSONLinesWriter writes snapshots as JSON Lines, with one JSON object per snapshot.
+//
+// Each object contains the receive time of the snapshot, when known, and the data of the snapshot.
+type JSONLinesWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+var _ Writer = &JSONLinesWriter{}
+
+// NewJSONLinesWriter returns a new JSON Lines writer.
+func NewJSONLinesWriter(w io.Writer) *JSONLinesWriter {
+	bw := bufio.NewWriter(w)
+	return &JSONLinesWriter{w: bw, enc: json.NewEncoder(bw)}
+}
+
+// jsonLine is a JSON Lines representation of a snapshot.
+type jsonLine struct {
+	Time *time.Time `json:",omitempty"`
+	*xsens.Snapshot
+}
+
+// Write a snapshot as a JSON line.
+func (j *JSONLinesWriter) Write(t time.Time, s *xsens.Snapshot) error {
+	line := jsonLine{Snapshot: s}
+	if !t.IsZero() {
+		t = t.UTC()
+		line.Time = &t
+	}
+	if err := j.enc.Encode(line); err != nil {
+		return fmt.Errorf("xsens export: write JSON line: %w", err)
+	}
+	return nil
+}
+
+// Flush any buffered data to the underlying writer.
+func (j *JSONLinesWriter) Flush() error {
+	if err := j.w.Flush(); err != nil {
+		return fmt.Errorf("xsens export: flush JSON lines: %w", err)
+	}
+	return nil
+}
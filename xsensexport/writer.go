@@ -0,0 +1,15 @@
+package xsensexport
+
+import (
+	"time"
+
+	"go.einride.tech/xsens"
+)
+
+// Writer writes snapshots of measurement data to an export format.
+type Writer interface {
+	// Write a snapshot received at the provided time. A zero time means the receive time is unknown.
+	Write(t time.Time, s *xsens.Snapshot) error
+	// Flush any buffered data to the underlying writer.
+	Flush() error
+}
@@ -0,0 +1,6 @@
+// Package xsensrecorder provides primitives for recording and replaying Xsens message streams.
+//
+// A recording starts with a header containing a magic string, a format version and the start time of the recording.
+// The header is followed by one record per message, containing the time since the previous record in nanoseconds
+// (uvarint), the length of the message (uvarint) and the raw bytes of the message.
+package xsensrecorder
@@ -0,0 +1,60 @@
+package xsensrecorder
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"go.einride.tech/xsens"
+)
+
+// RecordingPort is a port that records every message read from an underlying port.
+type RecordingPort struct {
+	p   io.ReadWriteCloser
+	w   *Writer
+	buf []byte
+}
+
+// NewRecordingPort returns a new port that records every message read from p to w.
+//
+// Messages are recorded with the host time at which they were completely read.
+func NewRecordingPort(p io.ReadWriteCloser, w *Writer) *RecordingPort {
+	return &RecordingPort{p: p, w: w}
+}
+
+// Read from the underlying port, recording every completely read message.
+func (r *RecordingPort) Read(b []byte) (int, error) {
+	n, err := r.p.Read(b)
+	if n > 0 {
+		now := time.Now()
+		r.buf = append(r.buf, b[:n]...)
+		data := r.buf
+		for len(data) > 0 {
+			advance, token, _ := xsens.ScanMessages(data, false)
+			if advance == 0 {
+				break
+			}
+			data = data[advance:]
+			if token == nil {
+				continue
+			}
+			if errWrite := r.w.WriteMessage(now, token); errWrite != nil {
+				err = fmt.Errorf("xsens recorder: read: %w", errWrite)
+				break
+			}
+		}
+		// keep the remaining data at the start of the buffer
+		r.buf = r.buf[:copy(r.buf, data)]
+	}
+	return n, err
+}
+
+// Write to the underlying port.
+func (r *RecordingPort) Write(b []byte) (int, error) {
+	return r.p.Write(b)
+}
+
+// Close the underlying port.
+func (r *RecordingPort) Close() error {
+	return r.p.Close()
+}
@@ -0,0 +1,106 @@
+package xsensrecorder
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"go.einride.tech/xsens"
+)
+
+// maxMessageLength is the maximum length of a recorded message.
+const maxMessageLength = 1 << 16
+
+// Reader reads messages from a recording.
+type Reader struct {
+	r       *bufio.Reader
+	start   time.Time
+	time    time.Time
+	message xsens.Message
+	err     error
+}
+
+// NewReader returns a new Reader that reads a recording from r.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	header := make([]byte, headerLength)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, fmt.Errorf("xsens recorder: new reader: read header: %w", err)
+	}
+	if string(header[:len(magic)]) != magic {
+		return nil, fmt.Errorf("xsens recorder: new reader: not a recording")
+	}
+	if v := header[len(magic)]; v != version {
+		return nil, fmt.Errorf("xsens recorder: new reader: unsupported version: %d", v)
+	}
+	start := time.Unix(0, int64(binary.BigEndian.Uint64(header[len(magic)+1:])))
+	return &Reader{r: br, start: start, time: start}, nil
+}
+
+// Start returns the start time of the recording.
+func (r *Reader) Start() time.Time {
+	return r.start
+}
+
+// Scan advances to the next message of the recording.
+//
+// Returns false when the end of the recording is reached, or an error occurs.
+func (r *Reader) Scan() bool {
+	if r.err != nil {
+		return false
+	}
+	delta, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		if !errors.Is(err, io.EOF) {
+			r.err = fmt.Errorf("xsens recorder: scan: read time: %w", err)
+		}
+		return false
+	}
+	length, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		r.err = fmt.Errorf("xsens recorder: scan: read length: %w", unexpectedEOF(err))
+		return false
+	}
+	if length > maxMessageLength {
+		r.err = fmt.Errorf("xsens recorder: scan: invalid message length: %d", length)
+		return false
+	}
+	if cap(r.message) < int(length) {
+		r.message = make(xsens.Message, length)
+	}
+	r.message = r.message[:length]
+	if _, err := io.ReadFull(r.r, r.message); err != nil {
+		r.err = fmt.Errorf("xsens recorder: scan: read message: %w", unexpectedEOF(err))
+		return false
+	}
+	r.time = r.time.Add(time.Duration(delta))
+	return true
+}
+
+// Message returns the current message.
+//
+// The message is only valid until the next call to Scan.
+func (r *Reader) Message() xsens.Message {
+	return r.message
+}
+
+// Time returns the receive time of the current message.
+func (r *Reader) Time() time.Time {
+	return r.time
+}
+
+// Err returns the first error that was encountered by the reader.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// unexpectedEOF returns io.ErrUnexpectedEOF if err is io.EOF, otherwise err.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,131 @@
+package xsensrecorder_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"go.einride.tech/xsens"
+	"go.einride.tech/xsens/xsensrecorder"
+	"gotest.tools/v3/assert"
+)
+
+func TestWriter_Reader(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	messages := []xsens.Message{
+		xsens.NewMessage(xsens.MessageIdentifierWakeup, nil),
+		xsens.NewMessage(xsens.MessageIdentifierMTData2, []byte{0x10, 0x20, 0x02, 0x00, 0x01}),
+		xsens.NewMessage(xsens.MessageIdentifierMTData2, bytes.Repeat([]byte{0x01}, 300)),
+	}
+	times := []time.Time{
+		start.Add(time.Millisecond),
+		start.Add(11 * time.Millisecond),
+		start.Add(12 * time.Millisecond),
+	}
+	var recording bytes.Buffer
+	w, err := xsensrecorder.NewWriter(&recording, start)
+	assert.NilError(t, err)
+	for i, m := range messages {
+		assert.NilError(t, w.WriteMessage(times[i], m))
+	}
+	r, err := xsensrecorder.NewReader(bytes.NewReader(recording.Bytes()))
+	assert.NilError(t, err)
+	assert.Assert(t, start.Equal(r.Start()))
+	for i, m := range messages {
+		assert.Assert(t, r.Scan())
+		assert.DeepEqual(t, m, r.Message())
+		assert.Assert(t, times[i].Equal(r.Time()))
+	}
+	assert.Assert(t, !r.Scan())
+	assert.NilError(t, r.Err())
+
+	// a truncated recording should be reported
+	r, err = xsensrecorder.NewReader(bytes.NewReader(recording.Bytes()[:recording.Len()-1]))
+	assert.NilError(t, err)
+	assert.Assert(t, r.Scan())
+	assert.Assert(t, r.Scan())
+	assert.Assert(t, !r.Scan())
+	assert.Assert(t, errors.Is(r.Err(), io.ErrUnexpectedEOF))
+
+	_, err = xsensrecorder.NewReader(bytes.NewReader([]byte("definitely not a recording")))
+	assert.ErrorContains(t, err, "not a recording")
+}
+
+// readOnlyPort is a port that reads from a reader and discards written data.
+type readOnlyPort struct {
+	io.Reader
+}
+
+func (readOnlyPort) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (readOnlyPort) Close() error {
+	return nil
+}
+
+// receiveAll receives all messages from a port using a client.
+func receiveAll(t *testing.T, p io.ReadWriteCloser) []xsens.Message {
+	t.Helper()
+	client := xsens.NewClient(p)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var result []xsens.Message
+	for {
+		if err := client.Receive(ctx); err != nil {
+			assert.Assert(t, errors.Is(err, io.EOF), err)
+			return result
+		}
+		result = append(result, append(xsens.Message(nil), client.RawMessage()...))
+	}
+}
+
+func TestRecordingPort_Replayer(t *testing.T) {
+	data, err := os.ReadFile("../testdata/1/output.bin")
+	assert.NilError(t, err)
+	var recording bytes.Buffer
+	w, err := xsensrecorder.NewWriter(&recording, time.Now())
+	assert.NilError(t, err)
+	expected := receiveAll(t, xsensrecorder.NewRecordingPort(readOnlyPort{Reader: bytes.NewReader(data)}, w))
+	assert.Assert(t, len(expected) > 0)
+
+	r, err := xsensrecorder.NewReader(&recording)
+	assert.NilError(t, err)
+	actual := receiveAll(t, xsensrecorder.NewReplayer(r, xsensrecorder.WithSpeed(0)))
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestReplayer_Speed(t *testing.T) {
+	start := time.Now()
+	message := xsens.NewMessage(xsens.MessageIdentifierWakeup, nil)
+	var recording bytes.Buffer
+	w, err := xsensrecorder.NewWriter(&recording, start)
+	assert.NilError(t, err)
+	assert.NilError(t, w.WriteMessage(start, message))
+	assert.NilError(t, w.WriteMessage(start.Add(time.Second), message))
+	assert.NilError(t, w.WriteMessage(start.Add(time.Hour), message))
+	r, err := xsensrecorder.NewReader(&recording)
+	assert.NilError(t, err)
+	replayer := xsensrecorder.NewReplayer(r, xsensrecorder.WithSpeed(10))
+	buf := make([]byte, len(message))
+
+	// the second message should be replayed ten times faster than recorded
+	_, err = io.ReadFull(replayer, buf)
+	assert.NilError(t, err)
+	replayStart := time.Now()
+	_, err = io.ReadFull(replayer, buf)
+	assert.NilError(t, err)
+	elapsed := time.Since(replayStart)
+	assert.Assert(t, elapsed >= 90*time.Millisecond && elapsed < time.Second, elapsed)
+
+	// closing the replayer should abort waiting for the third message
+	time.AfterFunc(10*time.Millisecond, func() {
+		assert.NilError(t, replayer.Close())
+	})
+	_, err = replayer.Read(buf)
+	assert.Assert(t, errors.Is(err, os.ErrClosed))
+}
@@ -0,0 +1,125 @@
+package xsensrecorder
+
+import (
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Replayer is a port that replays the messages of a recording, with their original timing.
+//
+// Data written to a replayer is discarded.
+type Replayer struct {
+	r         *Reader
+	opts      *replayerOptions
+	start     time.Time
+	data      []byte
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// NewReplayer returns a new port that replays the messages read by r.
+func NewReplayer(r *Reader, opts ...ReplayerOption) *Replayer {
+	options := defaultReplayerOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &Replayer{
+		r:      r,
+		opts:   options,
+		closed: make(chan struct{}),
+	}
+}
+
+// Read replayed data, waiting until the next message is due.
+//
+// Returns io.EOF at the end of the recording, and os.ErrClosed after the replayer has been closed.
+func (r *Replayer) Read(b []byte) (int, error) {
+	select {
+	case <-r.closed:
+		return 0, os.ErrClosed
+	default:
+	}
+	if len(r.data) == 0 {
+		if !r.r.Scan() {
+			if err := r.r.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		if err := r.wait(); err != nil {
+			return 0, err
+		}
+		r.data = r.r.Message()
+	}
+	n := copy(b, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+// wait until the current message of the reader is due.
+func (r *Replayer) wait() error {
+	if r.start.IsZero() {
+		// the replay starts at the first read
+		r.start = time.Now()
+	}
+	if r.opts.speed <= 0 {
+		return nil
+	}
+	offset := time.Duration(float64(r.r.Time().Sub(r.r.Start())) / r.opts.speed)
+	d := time.Until(r.start.Add(offset))
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-r.closed:
+		return os.ErrClosed
+	}
+}
+
+// Write discards the written data.
+func (r *Replayer) Write(b []byte) (int, error) {
+	select {
+	case <-r.closed:
+		return 0, os.ErrClosed
+	default:
+	}
+	return len(b), nil
+}
+
+// Close the replayer, aborting any pending read.
+func (r *Replayer) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.closed)
+	})
+	return nil
+}
+
+type replayerOptions struct {
+	// speed is the replay speed factor relative to the original timing.
+	speed float64
+}
+
+// defaultReplayerOptions returns replayerOptions with sensible default values.
+func defaultReplayerOptions() *replayerOptions {
+	return &replayerOptions{
+		speed: 1,
+	}
+}
+
+// ReplayerOption configures a Replayer.
+type ReplayerOption func(*replayerOptions)
+
+// WithSpeed configures the replay speed factor relative to the original timing.
+//
+// A speed of 2 replays twice as fast, and a speed of 0 replays as fast as possible.
+func WithSpeed(speed float64) ReplayerOption {
+	return func(opt *replayerOptions) {
+		opt.speed = speed
+	}
+}
@@ -0,0 +1,59 @@
+package xsensrecorder
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+
+	"go.einride.tech/xsens"
+)
+
+const (
+	// magic is the magic string at the start of every recording.
+	magic = "XSENSREC"
+	// version is the current version of the recording format.
+	version = 1
+	// headerLength is the length of the recording header.
+	headerLength = len(magic) + 1 + 8
+)
+
+// Writer writes messages to a recording.
+type Writer struct {
+	w    io.Writer
+	last time.Time
+	buf  []byte
+}
+
+// NewWriter returns a new Writer that writes a recording starting at the provided time to w.
+func NewWriter(w io.Writer, start time.Time) (*Writer, error) {
+	header := make([]byte, headerLength)
+	copy(header, magic)
+	header[len(magic)] = version
+	binary.BigEndian.PutUint64(header[len(magic)+1:], uint64(start.UnixNano()))
+	if _, err := w.Write(header); err != nil {
+		return nil, fmt.Errorf("xsens recorder: new writer: %w", err)
+	}
+	return &Writer{w: w, last: start}, nil
+}
+
+// WriteMessage writes a message received at the provided time to the recording.
+//
+// Messages must be written in the order they were received. A message received before the previous message is
+// recorded as received at the same time as the previous message.
+func (w *Writer) WriteMessage(t time.Time, m xsens.Message) error {
+	delta := t.Sub(w.last)
+	if delta < 0 {
+		delta = 0
+	} else {
+		w.last = t
+	}
+	var header [2 * binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(header[:], uint64(delta))
+	n += binary.PutUvarint(header[n:], uint64(len(m)))
+	w.buf = append(append(w.buf[:0], header[:n]...), m...)
+	if _, err := w.w.Write(w.buf); err != nil {
+		return fmt.Errorf("xsens recorder: write message: %w", err)
+	}
+	return nil
+}